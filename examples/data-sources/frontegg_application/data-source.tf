@@ -0,0 +1,11 @@
+data "frontegg_application" "portal" {
+  name = "Customer Portal"
+}
+
+output "portal_client_id" {
+  value = data.frontegg_application.portal.client_id
+}
+
+output "portal_tenants" {
+  value = data.frontegg_application.portal.tenant_ids
+}
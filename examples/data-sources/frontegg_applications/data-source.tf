@@ -0,0 +1,9 @@
+data "frontegg_applications" "managed_web" {
+  type        = "web"
+  access_type = "MANAGED_ACCESS"
+}
+
+# Publish application IDs by name for product teams to consume.
+output "application_ids" {
+  value = { for app in data.frontegg_applications.managed_web.applications : app.name => app.id }
+}
@@ -0,0 +1,14 @@
+data "frontegg_event_catalog" "this" {}
+
+# Subscribe a webhook to every user event.
+resource "frontegg_webhook" "user_events" {
+  enabled     = true
+  name        = "User events"
+  description = "Forwards all user events to the audit pipeline"
+  url         = "https://audit.example.com/frontegg"
+  secret      = var.webhook_secret
+  events = [
+    for event in data.frontegg_event_catalog.this.webhook_events : event.key
+    if startswith(event.key, "frontegg.user.")
+  ]
+}
@@ -0,0 +1,12 @@
+data "frontegg_feature" "sso" {
+  key = "sso"
+}
+
+data "frontegg_plan" "enterprise" {
+  name = "Enterprise"
+}
+
+resource "frontegg_plan_feature" "enterprise_sso" {
+  plan_id     = data.frontegg_plan.enterprise.id
+  feature_ids = [data.frontegg_feature.sso.id]
+}
@@ -0,0 +1,9 @@
+# Every feature created in the portal under the "reports." namespace.
+data "frontegg_features" "reports" {
+  key_prefix = "reports."
+}
+
+resource "frontegg_plan_feature" "reports" {
+  plan_id     = frontegg_plan.example.id
+  feature_ids = data.frontegg_features.reports.ids
+}
@@ -0,0 +1,11 @@
+data "frontegg_permissions" "billing" {
+  key_glob = "billing.*"
+}
+
+# A role granting every billing permission, including ones added later.
+resource "frontegg_role" "billing_admin" {
+  name           = "Billing Admin"
+  key            = "billing-admin"
+  description    = "Full access to billing."
+  permission_ids = data.frontegg_permissions.billing.ids
+}
@@ -0,0 +1,10 @@
+data "frontegg_role" "admin" {
+  key = "Admin"
+}
+
+# Assign the role to a user without hard-coding its ID.
+resource "frontegg_user" "example" {
+  email     = "admin@example.com"
+  tenant_id = "11111111-1111-1111-1111-111111111111"
+  role_ids  = [data.frontegg_role.admin.id]
+}
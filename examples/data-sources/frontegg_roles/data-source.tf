@@ -0,0 +1,13 @@
+data "frontegg_permission" "read_invoices" {
+  key = "billing.invoices.read"
+}
+
+# Every billing role that can read invoices.
+data "frontegg_roles" "billing_readers" {
+  key_prefix     = "billing."
+  permission_ids = [data.frontegg_permission.read_invoices.id]
+}
+
+output "billing_reader_role_ids" {
+  value = data.frontegg_roles.billing_readers.ids
+}
@@ -0,0 +1,14 @@
+data "frontegg_signing_keys" "this" {
+  algorithm = "RS256"
+}
+
+# Pin the current signing keys as Kong JWT credentials; rotations are picked up
+# on the next apply.
+resource "kong_jwt_auth" "frontegg" {
+  for_each = { for key in data.frontegg_signing_keys.this.keys : key.kid => key }
+
+  consumer_id    = kong_consumer.frontegg.id
+  key            = each.key
+  algorithm      = each.value.alg
+  rsa_public_key = each.value.pem
+}
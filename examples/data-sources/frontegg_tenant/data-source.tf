@@ -0,0 +1,7 @@
+data "frontegg_tenant" "acme" {
+  key = "acme"
+}
+
+output "acme_metadata" {
+  value = data.frontegg_tenant.acme.metadata
+}
@@ -0,0 +1,20 @@
+resource "frontegg_tenant" "reseller" {
+  name = "Reseller"
+  key  = "reseller"
+}
+
+resource "frontegg_tenant" "customer" {
+  name             = "Customer"
+  key              = "reseller-customer"
+  parent_tenant_id = frontegg_tenant.reseller.key
+}
+
+data "frontegg_tenant_hierarchy" "reseller" {
+  tenant_id = frontegg_tenant.reseller.key
+
+  depends_on = [frontegg_tenant.customer]
+}
+
+output "sub_accounts" {
+  value = [for t in data.frontegg_tenant_hierarchy.reseller.tenants : t.key if t.depth > 0]
+}
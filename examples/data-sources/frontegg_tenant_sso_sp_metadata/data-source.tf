@@ -0,0 +1,19 @@
+data "frontegg_tenant_sso_sp_metadata" "acme" {
+  tenant_id = frontegg_tenant_saml_config.acme.tenant_id
+  config_id = frontegg_tenant_saml_config.acme.id
+}
+
+# Register Frontegg as a SAML application in the customer's Okta org.
+resource "okta_app_saml" "frontegg" {
+  label                    = "Frontegg"
+  sso_url                  = data.frontegg_tenant_sso_sp_metadata.acme.acs_url
+  recipient                = data.frontegg_tenant_sso_sp_metadata.acme.acs_url
+  destination              = data.frontegg_tenant_sso_sp_metadata.acme.acs_url
+  audience                 = data.frontegg_tenant_sso_sp_metadata.acme.sp_entity_id
+  subject_name_id_template = "$${user.email}"
+  subject_name_id_format   = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  response_signed          = true
+  signature_algorithm      = "RSA_SHA256"
+  digest_algorithm         = "SHA256"
+  authn_context_class_ref  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+}
@@ -0,0 +1,14 @@
+# Every enterprise tenant, including those created by signup.
+data "frontegg_tenants" "enterprise" {
+  selected_metadata = {
+    plan = "enterprise"
+  }
+}
+
+resource "frontegg_tenant_mfa_policy" "enterprise" {
+  for_each = toset(data.frontegg_tenants.enterprise.ids)
+
+  tenant_id             = each.value
+  enforce_mfa_type      = "on"
+  mfa_device_expiration = 86400
+}
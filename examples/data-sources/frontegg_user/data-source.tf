@@ -0,0 +1,12 @@
+data "frontegg_user" "alice" {
+  email     = "alice@example.com"
+  tenant_id = "11111111-1111-1111-1111-111111111111"
+}
+
+resource "frontegg_entitlement" "alice_pro" {
+  entitlement {
+    plan_id   = "00000000-0000-0000-0000-000000000001"
+    tenant_id = data.frontegg_user.alice.tenant_id
+    user_id   = data.frontegg_user.alice.id
+  }
+}
@@ -0,0 +1,14 @@
+data "frontegg_role" "admin" {
+  key = "admin"
+}
+
+# Verified admins of a tenant, for a membership audit.
+data "frontegg_users" "tenant_admins" {
+  tenant_id = "11111111-1111-1111-1111-111111111111"
+  role_id   = data.frontegg_role.admin.id
+  verified  = true
+}
+
+output "tenant_admin_emails" {
+  value = data.frontegg_users.tenant_admins.users[*].email
+}
@@ -0,0 +1,18 @@
+data "frontegg_workspace" "this" {}
+
+# Publish the verification records of every custom domain in Route 53.
+resource "aws_route53_record" "frontegg" {
+  for_each = {
+    for record in flatten(data.frontegg_workspace.this.custom_domains[*].records) : record.name => record
+  }
+
+  zone_id = var.zone_id
+  name    = each.value.name
+  type    = each.value.type
+  ttl     = 300
+  records = [each.value.value]
+}
+
+output "saml_acs_url" {
+  value = one(data.frontegg_workspace.this.saml[*].acs_url)
+}
@@ -10,6 +10,10 @@ resource "frontegg_application" "example" {
   frontend_stack = "react"
   description    = "An example application"
 
+  # Refuse to destroy or replace this application until this is set to false
+  # in a separate apply.
+  deletion_protection = true
+
   metadata = {
     environment = "production"
     team        = "platform"
@@ -0,0 +1,7 @@
+import {
+  to = frontegg_application_tenant_assignment.example
+  identity = {
+    app_id    = "app-1234567890"
+    tenant_id = "tenant-1234567890"
+  }
+}
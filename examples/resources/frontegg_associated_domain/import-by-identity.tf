@@ -0,0 +1,7 @@
+import {
+  to = frontegg_associated_domain.ios
+  identity = {
+    platform         = "ios"
+    configuration_id = "your-configuration-id"
+  }
+}
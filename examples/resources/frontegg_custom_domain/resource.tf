@@ -0,0 +1,12 @@
+resource "frontegg_custom_domain" "auth" {
+  domain = "auth.example.com"
+}
+
+# Publish the CNAME record Frontegg verifies the domain with.
+resource "aws_route53_record" "auth" {
+  zone_id = var.zone_id
+  name    = frontegg_custom_domain.auth.records[0].name
+  type    = frontegg_custom_domain.auth.records[0].type
+  ttl     = 300
+  records = [frontegg_custom_domain.auth.records[0].value]
+}
@@ -0,0 +1,18 @@
+resource "frontegg_custom_domain" "auth" {
+  domain = "auth.example.com"
+}
+
+resource "aws_route53_record" "auth" {
+  zone_id = var.zone_id
+  name    = frontegg_custom_domain.auth.records[0].name
+  type    = frontegg_custom_domain.auth.records[0].type
+  ttl     = 300
+  records = [frontegg_custom_domain.auth.records[0].value]
+}
+
+# Waits for the verification once the record above has been published.
+resource "frontegg_custom_domain_verification" "auth" {
+  custom_domain_id = frontegg_custom_domain.auth.id
+
+  depends_on = [aws_route53_record.auth]
+}
@@ -0,0 +1,8 @@
+# Absorb every entitlement of a tenant. Use plan_id to import by plan, or
+# entitlement_ids to import an explicit list.
+import {
+  to = frontegg_entitlement.example
+  identity = {
+    tenant_id = "11111111-1111-1111-1111-111111111111"
+  }
+}
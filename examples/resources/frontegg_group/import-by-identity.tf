@@ -0,0 +1,7 @@
+import {
+  to = frontegg_group.example
+  identity = {
+    tenant_id = "your-tenant-id"
+    group_id  = "your-group-id"
+  }
+}
@@ -0,0 +1,14 @@
+resource "frontegg_group" "example" {
+  tenant_id   = frontegg_tenant.example.key
+  name        = "Engineering"
+  description = "Everyone in engineering"
+  color       = "#4F46E5"
+
+  metadata = {
+    cost_center = "42"
+  }
+
+  role_ids = [
+    frontegg_role.example.id,
+  ]
+}
@@ -0,0 +1,8 @@
+resource "frontegg_group_membership" "example" {
+  tenant_id = frontegg_group.example.tenant_id
+  group_id  = frontegg_group.example.id
+
+  user_ids = [
+    frontegg_user.example.id,
+  ]
+}
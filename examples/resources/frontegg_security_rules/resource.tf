@@ -0,0 +1,23 @@
+resource "frontegg_security_rules" "example" {
+  # Ask for a second factor when a user's password appears in a known breach.
+  breached_password {
+    action = "mfa_challenge"
+  }
+
+  bot_detection {
+    action = "block"
+  }
+
+  suspicious_ip {
+    action = "mfa_challenge"
+  }
+
+  # Only alert on impossible travel for now.
+  impossible_travel {
+    action = "notify"
+  }
+
+  # Restore the rules that were in place before this resource was created
+  # when it is destroyed.
+  on_destroy = "reset"
+}
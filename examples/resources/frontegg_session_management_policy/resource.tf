@@ -10,4 +10,8 @@ resource "frontegg_session_management_policy" "example" {
   # Allow at most 3 concurrent sessions per user.
   max_concurrent_sessions_enabled = true
   max_concurrent_sessions         = 3
+
+  # Restore the policy that was in place before this resource was created
+  # when it is destroyed.
+  on_destroy = "reset"
 }
@@ -0,0 +1,10 @@
+resource "frontegg_sms_template" "otc" {
+  template_type = "OTC"
+  sender_id     = "Example"
+  body          = "Your Example code is {{code}}. It expires in {{expirationTime}}."
+
+  localized_bodies = {
+    fr      = "Votre code Example est {{code}}. Il expire dans {{expirationTime}}."
+    "pt-BR" = "Seu código Example é {{code}}. Ele expira em {{expirationTime}}."
+  }
+}
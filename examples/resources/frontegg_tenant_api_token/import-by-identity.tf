@@ -0,0 +1,7 @@
+import {
+  to = frontegg_tenant_api_token.example
+  identity = {
+    tenant_id = "your-tenant-id"
+    client_id = "your-client-id"
+  }
+}
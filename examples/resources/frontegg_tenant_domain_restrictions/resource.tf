@@ -0,0 +1,8 @@
+resource "frontegg_tenant_domain_restrictions" "example" {
+  tenant_id = "your-tenant-id"
+
+  allowed_domains = [
+    "example.com",
+    "partner.example.com",
+  ]
+}
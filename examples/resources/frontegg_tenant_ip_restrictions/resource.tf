@@ -0,0 +1,15 @@
+resource "frontegg_tenant_ip_restrictions" "example" {
+  tenant_id = "your-tenant-id"
+  enabled   = true
+  mode      = "allow"
+
+  cidr {
+    cidr        = "203.0.113.0/24"
+    description = "Office"
+  }
+
+  cidr {
+    cidr        = "198.51.100.10"
+    description = "VPN egress"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = frontegg_tenant_saml_config.example
+  identity = {
+    tenant_id = "your-tenant-id"
+    config_id = "your-sso-config-id"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = frontegg_tenant_scim_configuration.example
+  identity = {
+    tenant_id      = "your-tenant-id"
+    scim_config_id = "your-scim-config-id"
+  }
+}
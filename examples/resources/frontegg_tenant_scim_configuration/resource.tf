@@ -0,0 +1,15 @@
+resource "frontegg_tenant_scim_configuration" "example" {
+  tenant_id       = "your-tenant-id"
+  source          = "okta"
+  connection_name = "Okta"
+}
+
+output "scim_base_url" {
+  value     = frontegg_tenant_scim_configuration.example.base_url
+  sensitive = true
+}
+
+output "scim_token" {
+  value     = frontegg_tenant_scim_configuration.example.token
+  sensitive = true
+}
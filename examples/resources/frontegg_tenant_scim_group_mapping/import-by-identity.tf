@@ -0,0 +1,8 @@
+import {
+  to = frontegg_tenant_scim_group_mapping.engineering
+  identity = {
+    tenant_id      = "your-tenant-id"
+    scim_config_id = "your-scim-config-id"
+    group_id       = "your-group-mapping-id"
+  }
+}
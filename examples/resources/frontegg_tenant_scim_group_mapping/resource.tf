@@ -0,0 +1,9 @@
+resource "frontegg_tenant_scim_group_mapping" "engineering" {
+  tenant_id      = frontegg_tenant_scim_configuration.example.tenant_id
+  scim_config_id = frontegg_tenant_scim_configuration.example.id
+  group          = "Engineering"
+
+  role_ids = [
+    frontegg_role.example.id,
+  ]
+}
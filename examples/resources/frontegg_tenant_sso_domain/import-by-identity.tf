@@ -0,0 +1,8 @@
+import {
+  to = frontegg_tenant_sso_domain.example
+  identity = {
+    tenant_id     = "your-tenant-id"
+    sso_config_id = "your-sso-config-id"
+    domain_id     = "your-domain-id"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = frontegg_tenant_sso_domain_validation.example
+  identity = {
+    tenant_id = "your-tenant-id"
+    domain    = "example.com"
+  }
+}
@@ -0,0 +1,8 @@
+import {
+  to = frontegg_tenant_sso_group_mapping.example
+  identity = {
+    tenant_id     = "your-tenant-id"
+    sso_config_id = "your-sso-config-id"
+    group_id      = "your-group-mapping-id"
+  }
+}
@@ -0,0 +1,7 @@
+import {
+  to = frontegg_user_tenant_membership.consultant_at_customer
+  identity = {
+    user_id   = "your-user-id"
+    tenant_id = "your-tenant-id"
+  }
+}
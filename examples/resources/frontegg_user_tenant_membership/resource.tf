@@ -0,0 +1,10 @@
+resource "frontegg_user_tenant_membership" "consultant_at_customer" {
+  user_id   = frontegg_user.consultant.id
+  tenant_id = frontegg_tenant.customer.key
+
+  role_ids = [
+    frontegg_role.viewer.id,
+  ]
+
+  skip_invite_email = true
+}
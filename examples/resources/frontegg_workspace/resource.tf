@@ -8,6 +8,8 @@ resource "frontegg_workspace" "example" {
   # If you've configured CNAME record,
   # you can use that custom domain like so:
   # custom_domains = ["frontegg.yourcompany.com"]
+  # and wait for it to be verified before the apply completes:
+  # wait_for_active = true
 
   frontegg_domain = "blah.frontegg.com"
   allowed_origins = ["https://yourcompany.com"]
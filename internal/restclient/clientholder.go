@@ -1,6 +1,12 @@
 package restclient
 
+import "sync"
+
 type ClientHolder struct {
 	ApiClient    Client
 	PortalClient Client
+
+	// Cache holds values fetched once per configured provider, such as the
+	// catalogs used for plan-time validation. Callers own their keys.
+	Cache sync.Map
 }
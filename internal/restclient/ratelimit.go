@@ -186,6 +186,19 @@ func parseRetryAfter(h http.Header, now time.Time) (time.Duration, bool) {
 	return 0, false
 }
 
+// exceedsDeadline reports whether waiting d from now would outlast ctx's
+// deadline, along with the time remaining before it. Resource timeouts reach
+// the client as context deadlines, so a reset window the operation cannot
+// survive is reported immediately instead of being slept through.
+func exceedsDeadline(ctx context.Context, d time.Duration, now time.Time) (time.Duration, bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return 0, false
+	}
+	remaining := deadline.Sub(now)
+	return remaining, d > remaining
+}
+
 // waitContext waits for d, returning early with the context error if ctx is
 // cancelled or its deadline passes first.
 func waitContext(ctx context.Context, d time.Duration) error {
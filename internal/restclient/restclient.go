@@ -52,6 +52,13 @@ func IsNotFound(err error) bool {
 	return err != nil && strings.Contains(err.Error(), ": 404 ")
 }
 
+// RateLimitWait reports how long a request with method to url would currently
+// have to wait before being sent because its route is rate limited. Pollers
+// use it to avoid scheduling requests inside a known reset window.
+func (c *Client) RateLimitWait(method string, url string) time.Duration {
+	return c.rl.waitBeforeSend(c.rl.routeKey(method, url), time.Now())
+}
+
 func (c *Client) DeleteWithHeaders(ctx context.Context, url string, headers http.Header, out interface{}) error {
 	return c.RequestWithHeaders(ctx, "DELETE", url, headers, nil, out)
 }
@@ -167,6 +174,12 @@ func (c *Client) RequestWithHeaders(ctx context.Context, method string, url stri
 					attempts, totalWait, method, c.baseURL, url,
 				)
 			}
+			if remaining, ok := exceedsDeadline(ctx, wait, time.Now()); ok {
+				return fmt.Errorf(
+					"restclient: rate limited for %s but only %s of the operation timeout remains; increase the resource timeouts to wait it out: %s %s%s: %w",
+					wait, remaining.Round(time.Second), method, c.baseURL, url, context.DeadlineExceeded,
+				)
+			}
 			if err := waitContext(ctx, wait); err != nil {
 				return err
 			}
@@ -208,6 +221,12 @@ func (c *Client) RequestWithHeaders(ctx context.Context, method string, url stri
 					attempts, totalWait, req.Method, req.URL, res.Status, res.Header, resBody,
 				)
 			}
+			if remaining, ok := exceedsDeadline(ctx, wait, time.Now()); ok {
+				return fmt.Errorf(
+					"restclient: rate limited for %s but only %s of the operation timeout remains; increase the resource timeouts to wait it out: %s %s: %s: %w",
+					wait, remaining.Round(time.Second), req.Method, req.URL, res.Status, context.DeadlineExceeded,
+				)
+			}
 			log.Printf(
 				"[WARN] rate limited on %s; waiting %s (source: %s) before retry %d",
 				routeKey, wait, source, attempts,
@@ -2,6 +2,7 @@ package restclient
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -274,6 +275,74 @@ func TestContextCancelDuringWait(t *testing.T) {
 	}
 }
 
+// TestWaitBeyondDeadlineFailsFast verifies that a reset window longer than the
+// time left on the context deadline (the resource timeout) is reported at once
+// as a timeout instead of being slept until the deadline fires.
+func TestWaitBeyondDeadlineFailsFast(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set(rateLimitResetHeader, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(srv.URL)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	err := c.Get(ctx, "/thing", nil)
+	if err == nil {
+		t.Fatalf("expected a timeout error, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected error to wrap context.DeadlineExceeded, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "operation timeout") {
+		t.Fatalf("expected error to mention the operation timeout, got: %v", err)
+	}
+	if time.Since(start) > 2*time.Second {
+		t.Fatalf("expected to fail fast, took %v", time.Since(start))
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Fatalf("expected a single request, got %d", calls)
+	}
+
+	// The route is now known to be limited; a second call must not wait either.
+	start = time.Now()
+	if err := c.Get(ctx, "/thing", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected pre-send wait to fail fast with a timeout, got: %v", err)
+	}
+	if time.Since(start) > 2*time.Second {
+		t.Fatalf("expected pre-send wait to fail fast, took %v", time.Since(start))
+	}
+}
+
+func TestRateLimitWaitReportsRecordedReset(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitResetHeader, time.Now().Add(1*time.Hour).UTC().Format(time.RFC3339))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(srv.URL)
+	if wait := c.RateLimitWait("GET", "/thing"); wait != 0 {
+		t.Fatalf("expected no wait before any 429, got %v", wait)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	_ = c.Get(ctx, "/thing?page=1", nil)
+
+	if wait := c.RateLimitWait("GET", "/thing?page=2"); wait < 50*time.Minute {
+		t.Fatalf("expected the recorded reset to apply to the route, got %v", wait)
+	}
+	if wait := c.RateLimitWait("POST", "/thing"); wait != 0 {
+		t.Fatalf("expected other methods to be unaffected, got %v", wait)
+	}
+}
+
 // TestSafetyCeilingAttempts verifies the attempts ceiling fires and returns the
 // "gave up after N attempts" error — using a background context (no deadline)
 // so the ceiling, not the context, is what stops the loop (TEST-001).
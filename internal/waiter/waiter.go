@@ -0,0 +1,176 @@
+// Package waiter polls Frontegg APIs until an asynchronous operation, such as
+// custom domain verification or custom code provisioning, converges.
+//
+// A wait repeatedly GETs a path, hands the decoded response to a status check,
+// and sleeps with exponential backoff between attempts. The backoff never
+// schedules a poll inside a rate-limit window the REST client already knows
+// about, and the wait is bounded by the context deadline, which carries the
+// resource's configured timeout.
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+const (
+	// DefaultMinInterval is the delay before the second poll.
+	DefaultMinInterval = 2 * time.Second
+	// DefaultMaxInterval caps the backoff between polls.
+	DefaultMaxInterval = 30 * time.Second
+)
+
+// Client is the subset of restclient.Client used to poll.
+type Client interface {
+	GetWithHeaders(ctx context.Context, url string, headers http.Header, out interface{}) error
+	RateLimitWait(method string, url string) time.Duration
+}
+
+var _ Client = (*restclient.Client)(nil)
+
+// Result is a single observation of the object being waited on.
+type Result struct {
+	// Status describes the observed state. It is logged on every poll and
+	// reported if the wait times out.
+	Status string
+	// Done reports whether the target state has been reached.
+	Done bool
+}
+
+// Config describes a wait for a response of type T.
+type Config[T any] struct {
+	// Description names what is being waited for, completing the sentence
+	// "waiting for ...", e.g. `custom domain "auth.example.com" to become Active`.
+	Description string
+	// Path and Headers identify the GET request to poll.
+	Path    string
+	Headers http.Header
+	// Check evaluates a decoded response. Returning an error stops the wait,
+	// which is how terminal failure states are reported.
+	Check func(out *T) (Result, error)
+	// Pending optionally reports whether a GET error means the object is not
+	// ready yet (for example a 404 while it is being provisioned) rather than
+	// a failure. Any other error stops the wait.
+	Pending func(err error) bool
+	// MinInterval and MaxInterval bound the exponential backoff between polls.
+	// Zero values use DefaultMinInterval and DefaultMaxInterval.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+}
+
+// TimeoutError is returned when the context deadline passes, or would pass
+// before the next poll, without the target state being reached.
+type TimeoutError struct {
+	Description string
+	Elapsed     time.Duration
+	Attempts    int
+	// LastStatus is the status from the last successful check, if any.
+	LastStatus string
+	// LastErr is the last pending or rate-limit error, if any.
+	LastErr error
+}
+
+func (e *TimeoutError) Error() string {
+	msg := fmt.Sprintf("timed out after %s (%d attempts) waiting for %s",
+		e.Elapsed.Round(time.Second), e.Attempts, e.Description)
+	if e.LastStatus != "" {
+		msg += fmt.Sprintf("; last status: %s", e.LastStatus)
+	}
+	if e.LastErr != nil {
+		msg += fmt.Sprintf("; last error: %v", e.LastErr)
+	}
+	return msg
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// Wait polls until cfg.Check reports the target state and returns the final
+// response. It fails with a *TimeoutError if ctx's deadline passes first, and
+// with the underlying error if a poll or check fails.
+func Wait[T any](ctx context.Context, c Client, cfg Config[T]) (*T, error) {
+	minInterval, maxInterval := cfg.MinInterval, cfg.MaxInterval
+	if minInterval <= 0 {
+		minInterval = DefaultMinInterval
+	}
+	if maxInterval < minInterval {
+		maxInterval = max(DefaultMaxInterval, minInterval)
+	}
+
+	start := time.Now()
+	timeout := func(attempts int, lastStatus string, lastErr error) error {
+		return &TimeoutError{
+			Description: cfg.Description,
+			Elapsed:     time.Since(start),
+			Attempts:    attempts,
+			LastStatus:  lastStatus,
+			LastErr:     lastErr,
+		}
+	}
+
+	var (
+		lastStatus string
+		lastErr    error
+	)
+	interval := minInterval
+	for attempt := 1; ; attempt++ {
+		out := new(T)
+		err := c.GetWithHeaders(ctx, cfg.Path, cfg.Headers, out)
+		switch {
+		case err == nil:
+			res, err := cfg.Check(out)
+			if err != nil {
+				return nil, fmt.Errorf("waiting for %s: %w", cfg.Description, err)
+			}
+			if res.Done {
+				return out, nil
+			}
+			lastStatus, lastErr = res.Status, nil
+			log.Printf("[DEBUG] waiting for %s: attempt %d, status: %s", cfg.Description, attempt, res.Status)
+		case errors.Is(err, context.DeadlineExceeded) || ctx.Err() != nil:
+			return nil, timeout(attempt, lastStatus, err)
+		case cfg.Pending != nil && cfg.Pending(err):
+			lastErr = err
+			log.Printf("[DEBUG] waiting for %s: attempt %d, not ready: %v", cfg.Description, attempt, err)
+		default:
+			return nil, fmt.Errorf("waiting for %s: %w", cfg.Description, err)
+		}
+
+		delay := max(interval, c.RateLimitWait(http.MethodGet, cfg.Path))
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return nil, timeout(attempt, lastStatus, lastErr)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, timeout(attempt, lastStatus, lastErr)
+		case <-timer.C:
+		}
+		interval = min(interval*2, maxInterval)
+	}
+}
+
+// Diagnostics converts an error from Wait into diagnostics. Timeouts get a
+// summary naming what was being waited for and a hint to raise the resource
+// timeout; other errors are passed through unchanged.
+func Diagnostics(err error) diag.Diagnostics {
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		return diag.FromErr(err)
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  fmt.Sprintf("Timed out waiting for %s", timeoutErr.Description),
+		Detail: fmt.Sprintf("%s.\n\nThe operation may still complete on the Frontegg side. "+
+			"Resolve the cause above, or increase the resource's timeouts block, and apply again.", err),
+	}}
+}
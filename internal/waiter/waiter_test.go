@@ -0,0 +1,163 @@
+package waiter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeStatus struct {
+	Status string `json:"status"`
+}
+
+// fakeClient serves a scripted sequence of responses, repeating the last one.
+type fakeClient struct {
+	responses []func(out interface{}) error
+	calls     int
+	rateLimit time.Duration
+}
+
+func (f *fakeClient) GetWithHeaders(ctx context.Context, url string, headers http.Header, out interface{}) error {
+	i := min(f.calls, len(f.responses)-1)
+	f.calls++
+	return f.responses[i](out)
+}
+
+func (f *fakeClient) RateLimitWait(method string, url string) time.Duration {
+	return f.rateLimit
+}
+
+func status(s string) func(out interface{}) error {
+	return func(out interface{}) error {
+		out.(*fakeStatus).Status = s
+		return nil
+	}
+}
+
+func fails(err error) func(out interface{}) error {
+	return func(out interface{}) error { return err }
+}
+
+func activeConfig() Config[fakeStatus] {
+	return Config[fakeStatus]{
+		Description: "thing to become Active",
+		Path:        "/thing",
+		Check: func(out *fakeStatus) (Result, error) {
+			if out.Status == "Failed" {
+				return Result{}, fmt.Errorf("thing failed")
+			}
+			return Result{Status: out.Status, Done: out.Status == "Active"}, nil
+		},
+		MinInterval: time.Millisecond,
+		MaxInterval: 4 * time.Millisecond,
+	}
+}
+
+func TestWaitUntilDone(t *testing.T) {
+	c := &fakeClient{responses: []func(interface{}) error{status("Pending"), status("Pending"), status("Active")}}
+	out, err := Wait(context.Background(), c, activeConfig())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if out.Status != "Active" || c.calls != 3 {
+		t.Fatalf("got status %q after %d calls, want Active after 3", out.Status, c.calls)
+	}
+}
+
+func TestWaitCheckErrorStops(t *testing.T) {
+	c := &fakeClient{responses: []func(interface{}) error{status("Pending"), status("Failed")}}
+	_, err := Wait(context.Background(), c, activeConfig())
+	if err == nil || !strings.Contains(err.Error(), "thing failed") {
+		t.Fatalf("expected the check error, got %v", err)
+	}
+	var timeoutErr *TimeoutError
+	if errors.As(err, &timeoutErr) {
+		t.Fatalf("a failed check must not be reported as a timeout")
+	}
+}
+
+func TestWaitPendingErrors(t *testing.T) {
+	notFound := errors.New("404 Not Found")
+	c := &fakeClient{responses: []func(interface{}) error{fails(notFound), status("Active")}}
+
+	cfg := activeConfig()
+	if _, err := Wait(context.Background(), c, cfg); !errors.Is(err, notFound) {
+		t.Fatalf("expected errors to stop the wait without a Pending func, got %v", err)
+	}
+
+	c.calls = 0
+	cfg.Pending = func(err error) bool { return errors.Is(err, notFound) }
+	if _, err := Wait(context.Background(), c, cfg); err != nil {
+		t.Fatalf("expected pending errors to be retried, got %v", err)
+	}
+}
+
+func TestWaitTimeout(t *testing.T) {
+	c := &fakeClient{responses: []func(interface{}) error{status("Pending")}}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := Wait(ctx, c, activeConfig())
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a *TimeoutError, got %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the timeout to wrap context.DeadlineExceeded")
+	}
+	if timeoutErr.LastStatus != "Pending" || timeoutErr.Attempts < 2 {
+		t.Fatalf("unexpected diagnostics: %+v", timeoutErr)
+	}
+
+	diags := Diagnostics(err)
+	if len(diags) != 1 || diags[0].Summary != "Timed out waiting for thing to become Active" {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+	if !strings.Contains(diags[0].Detail, "last status: Pending") || !strings.Contains(diags[0].Detail, "timeouts") {
+		t.Fatalf("expected the detail to report the last status and mention timeouts, got %q", diags[0].Detail)
+	}
+}
+
+func TestWaitRespectsRateLimit(t *testing.T) {
+	c := &fakeClient{
+		responses: []func(interface{}) error{status("Pending")},
+		rateLimit: time.Hour,
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := Wait(ctx, c, activeConfig())
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a *TimeoutError, got %v", err)
+	}
+	if c.calls != 1 || time.Since(start) > 2*time.Second {
+		t.Fatalf("expected to give up after one poll without waiting, got %d calls in %v", c.calls, time.Since(start))
+	}
+}
+
+func TestWaitRateLimitTimeoutFromClient(t *testing.T) {
+	limited := fmt.Errorf("restclient: rate limited: %w", context.DeadlineExceeded)
+	c := &fakeClient{responses: []func(interface{}) error{status("Pending"), fails(limited)}}
+
+	_, err := Wait(context.Background(), c, activeConfig())
+	var timeoutErr *TimeoutError
+	if !errors.As(err, &timeoutErr) {
+		t.Fatalf("expected a *TimeoutError, got %v", err)
+	}
+	if timeoutErr.LastStatus != "Pending" || !errors.Is(timeoutErr.LastErr, context.DeadlineExceeded) {
+		t.Fatalf("unexpected diagnostics: %+v", timeoutErr)
+	}
+}
+
+func TestDiagnosticsPassesThroughOtherErrors(t *testing.T) {
+	diags := Diagnostics(errors.New("boom"))
+	if len(diags) != 1 || diags[0].Summary != "boom" {
+		t.Fatalf("unexpected diagnostics: %+v", diags)
+	}
+}
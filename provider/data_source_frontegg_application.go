@@ -0,0 +1,143 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fronteggApplicationDataSourceSchema is the read-only view of an application
+// shared by frontegg_application and the elements of frontegg_applications.
+// Credentials are left out so that looking up an application never puts its
+// secrets in state.
+func fronteggApplicationDataSourceSchema() map[string]*schema.Schema {
+	s := resourceFronteggApplication().Schema
+	delete(s, "deletion_protection")
+	delete(s, "client_secret")
+	delete(s, "shared_secret")
+	for _, field := range s {
+		field.Required = false
+		field.Optional = false
+		field.Computed = true
+		field.Default = nil
+		field.ValidateFunc = nil
+	}
+	s["client_id"] = &schema.Schema{
+		Description: "The OAuth client ID of the application, which Frontegg sets to the application ID.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	s["tenant_ids"] = &schema.Schema{
+		Description: "The IDs of the tenants assigned to the application, sorted.",
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+	return s
+}
+
+func dataSourceFronteggApplication() *schema.Resource {
+	s := fronteggApplicationDataSourceSchema()
+	s["application_id"] = &schema.Schema{
+		Description:  "The ID of the application to look up.",
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ExactlyOneOf: []string{"application_id", "name"},
+	}
+	s["name"].Optional = true
+	s["name"].ExactlyOneOf = []string{"application_id", "name"}
+	return &schema.Resource{
+		Description: "Looks up a Frontegg application by name or ID and exposes its URLs, client ID and assigned tenants.",
+		ReadContext: dataSourceFronteggApplicationRead,
+		Schema:      s,
+	}
+}
+
+// flattenFronteggApplication converts app and its assigned tenants into the
+// attributes of fronteggApplicationDataSourceSchema.
+func flattenFronteggApplication(app fronteggApplication, tenantIDs []string) map[string]interface{} {
+	tenants := append([]string{}, tenantIDs...)
+	sort.Strings(tenants)
+	return map[string]interface{}{
+		"name":                    app.Name,
+		"app_url":                 app.AppURL,
+		"login_url":               app.LoginURL,
+		"logo_url":                app.LogoURL,
+		"access_type":             app.AccessType,
+		"is_default":              app.IsDefault,
+		"is_active":               app.IsActive,
+		"allow_dcr":               app.AllowDcr,
+		"type":                    app.Type,
+		"frontend_stack":          app.FrontendStack,
+		"description":             app.Description,
+		"metadata":                app.Metadata,
+		"integration_finished_at": app.IntegrationFinishedAt,
+		"created_at":              app.CreatedAt,
+		"updated_at":              app.UpdatedAt,
+		"client_id":               app.ID,
+		"tenant_ids":              tenants,
+	}
+}
+
+func fetchFronteggApplicationTenantIDs(ctx context.Context, clientHolder *restclient.ClientHolder, appID string) ([]string, error) {
+	var out fronteggApplicationTenantIds
+	if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/%s", fronteggApplicationTenantAssignmentPath, appID), &out); err != nil {
+		return nil, err
+	}
+	return out.TenantIds, nil
+}
+
+func dataSourceFronteggApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	appID := d.Get("application_id").(string)
+	name := d.Get("name").(string)
+
+	var match *fronteggApplication
+	if appID != "" {
+		var out fronteggApplication
+		if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/%s", fronteggApplicationPath, appID), &out); err != nil {
+			if restclient.IsNotFound(err) {
+				return diag.Errorf("unable to find application with ID %q", appID)
+			}
+			return diag.FromErr(err)
+		}
+		match = &out
+	} else {
+		var apps []fronteggApplication
+		if err := clientHolder.ApiClient.Get(ctx, fronteggApplicationPath, &apps); err != nil {
+			return diag.FromErr(err)
+		}
+		for i, app := range apps {
+			if app.Name != name {
+				continue
+			}
+			if match != nil {
+				return diag.Errorf("found more than one application named %q; look it up by application_id instead", name)
+			}
+			match = &apps[i]
+		}
+		if match == nil {
+			return diag.Errorf("unable to find application with name %q", name)
+		}
+	}
+
+	tenantIDs, err := fetchFronteggApplicationTenantIDs(ctx, clientHolder, match.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(match.ID)
+	if err := d.Set("application_id", match.ID); err != nil {
+		return diag.FromErr(err)
+	}
+	for k, v := range flattenFronteggApplication(*match, tenantIDs) {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFronteggApplications() *schema.Resource {
+	element := fronteggApplicationDataSourceSchema()
+	element["id"] = &schema.Schema{
+		Description: "The ID of the application.",
+		Type:        schema.TypeString,
+		Computed:    true,
+	}
+	return &schema.Resource{
+		Description: "Lists Frontegg applications, optionally filtered by type and access type.",
+		ReadContext: dataSourceFronteggApplicationsRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Description:  "Only include applications of this type.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"web", "mobile-ios", "mobile-android", "other"}, false),
+			},
+			"access_type": {
+				Description:  "Only include applications with this access type.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"FREE_ACCESS", "MANAGED_ACCESS"}, false),
+			},
+			"ids": {
+				Description: "The IDs of the matching applications, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"applications": {
+				Description: "The matching applications, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: element},
+			},
+		},
+	}
+}
+
+// filterFronteggApplications returns the applications of type appType and
+// access type accessType, where empty filters match everything, sorted by name.
+func filterFronteggApplications(apps []fronteggApplication, appType, accessType string) []fronteggApplication {
+	var out []fronteggApplication
+	for _, app := range apps {
+		if appType != "" && app.Type != appType {
+			continue
+		}
+		if accessType != "" && app.AccessType != accessType {
+			continue
+		}
+		out = append(out, app)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+func dataSourceFronteggApplicationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	var all []fronteggApplication
+	if err := clientHolder.ApiClient.Get(ctx, fronteggApplicationPath, &all); err != nil {
+		return diag.FromErr(err)
+	}
+
+	apps := filterFronteggApplications(all, d.Get("type").(string), d.Get("access_type").(string))
+
+	ids := make([]string, 0, len(apps))
+	out := make([]map[string]interface{}, 0, len(apps))
+	for _, app := range apps {
+		tenantIDs, err := fetchFronteggApplicationTenantIDs(ctx, clientHolder, app.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		flat := flattenFronteggApplication(app, tenantIDs)
+		flat["id"] = app.ID
+		ids = append(ids, app.ID)
+		out = append(out, flat)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("applications", out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterFronteggApplications(t *testing.T) {
+	apps := []fronteggApplication{
+		{ID: "3", Name: "Portal", Type: "web", AccessType: "MANAGED_ACCESS"},
+		{ID: "1", Name: "Admin", Type: "web", AccessType: "FREE_ACCESS"},
+		{ID: "2", Name: "Mobile", Type: "mobile-ios", AccessType: "MANAGED_ACCESS"},
+	}
+	ids := func(apps []fronteggApplication) []string {
+		var out []string
+		for _, app := range apps {
+			out = append(out, app.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		appType    string
+		accessType string
+		want       []string
+	}{
+		{"no filters sorts by name", "", "", []string{"1", "2", "3"}},
+		{"type", "web", "", []string{"1", "3"}},
+		{"access type", "", "MANAGED_ACCESS", []string{"2", "3"}},
+		{"both filters", "web", "MANAGED_ACCESS", []string{"3"}},
+		{"no match", "other", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(filterFronteggApplications(apps, tt.appType, tt.accessType))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterFronteggApplications() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlattenFronteggApplicationMatchesSchema(t *testing.T) {
+	flat := flattenFronteggApplication(fronteggApplication{
+		ID:       "app-1",
+		Name:     "Portal",
+		AppURL:   "https://app.example.com",
+		LoginURL: "https://app.example.com/login",
+		Metadata: map[string]string{"team": "platform"},
+	}, []string{"t2", "t1"})
+
+	if flat["client_id"] != "app-1" {
+		t.Errorf("client_id = %v, want the application ID", flat["client_id"])
+	}
+	if !reflect.DeepEqual(flat["tenant_ids"], []string{"t1", "t2"}) {
+		t.Errorf("tenant_ids = %v, want sorted tenants", flat["tenant_ids"])
+	}
+
+	d := dataSourceFronteggApplication().Data(nil)
+	for k, v := range flat {
+		if err := d.Set(k, v); err != nil {
+			t.Errorf("setting %s: %v", k, err)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggEventCatalog() *schema.Resource {
+	event := func(withCategory bool) *schema.Resource {
+		s := map[string]*schema.Schema{
+			"key": {
+				Description: "The event key to use in `events`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"name": {
+				Description: "The display name of the event.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"description": {
+				Description: "A description of when the event fires.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		}
+		if withCategory {
+			s["category"] = &schema.Schema{
+				Description: "The name of the category the event belongs to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			}
+		}
+		return &schema.Resource{Schema: s}
+	}
+	return &schema.Resource{
+		Description: "Lists the events that `frontegg_webhook` and `frontegg_prehook` can subscribe to.",
+		ReadContext: dataSourceFronteggEventCatalogRead,
+		Schema: map[string]*schema.Schema{
+			"webhook_events": {
+				Description: "The webhook events, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        event(true),
+			},
+			"prehook_events": {
+				Description: "The prehook events, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        event(false),
+			},
+		},
+	}
+}
+
+func dataSourceFronteggEventCatalogRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	catalog, err := fetchFronteggEventCatalog(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	webhookEvents := make([]map[string]interface{}, 0, len(catalog.Webhook))
+	for _, e := range catalog.Webhook {
+		webhookEvents = append(webhookEvents, map[string]interface{}{
+			"key":         e.Key,
+			"name":        e.DisplayName,
+			"description": e.Description,
+			"category":    catalog.Categories[e.CategoryID],
+		})
+	}
+	prehookEvents := make([]map[string]interface{}, 0, len(catalog.Prehook))
+	for _, e := range catalog.Prehook {
+		prehookEvents = append(prehookEvents, map[string]interface{}{
+			"key":         e.Key,
+			"name":        e.DisplayName,
+			"description": e.Description,
+		})
+	}
+
+	if err := d.Set("webhook_events", webhookEvents); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("prehook_events", prehookEvents); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type fronteggFeatureV2ListPage struct {
+	Items   []fronteggFeatureV2 `json:"items"`
+	HasNext bool                `json:"hasNext"`
+}
+
+// fronteggFeatureDataSourceSchema is the read-only view of a feature shared by
+// frontegg_feature and the elements of frontegg_features.
+func fronteggFeatureDataSourceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Description: "The ID of the feature (UUID).",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"key": {
+			Description: "The key of the feature.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"name": {
+			Description: "The name of the feature.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"description": {
+			Description: "A description of the feature.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"permissions": {
+			Description: "The permissions linked to the feature, sorted by key.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"permission_key": {
+						Description: "The key of the permission",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"permission_id": {
+						Description: "The ID of the permission",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"metadata": {
+			Description: "Metadata for the feature, as a JSON object.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"feature_flag": {
+			Description: "The feature flag backing the feature, if any.",
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Description: "The ID of the feature flag.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"name": {
+						Description: "The name of the feature flag.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"on": {
+						Description: "Whether the feature flag is on.",
+						Type:        schema.TypeBool,
+						Computed:    true,
+					},
+					"default_treatment": {
+						Description: "The treatment served when the flag is on and no rule matches.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"off_treatment": {
+						Description: "The treatment served when the flag is off.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"created_at": {
+			Description: "When the feature was created.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+		"updated_at": {
+			Description: "When the feature was last updated.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceFronteggFeature() *schema.Resource {
+	s := fronteggFeatureDataSourceSchema()
+	s["key"].Computed = false
+	s["key"].Required = true
+	return &schema.Resource{
+		Description: "Looks up a Frontegg feature by key and exposes its ID, linked permissions and feature flag, for composition with resources such as frontegg_plan_feature.",
+		ReadContext: dataSourceFronteggFeatureRead,
+		Schema:      s,
+	}
+}
+
+// listFronteggFeatures pages through every feature matching filters.
+func listFronteggFeatures(ctx context.Context, c *restclient.Client, filters url.Values) ([]fronteggFeatureV2, error) {
+	const limit = 10
+	offset := 0
+	var all []fronteggFeatureV2
+	for {
+		q := url.Values{}
+		for k, vs := range filters {
+			for _, v := range vs {
+				q.Add(k, v)
+			}
+		}
+		q.Set("offset", fmt.Sprintf("%d", offset))
+		q.Set("limit", fmt.Sprintf("%d", limit))
+
+		var page fronteggFeatureV2ListPage
+		if err := c.Get(ctx, fronteggFeaturePathV2+"?"+q.Encode(), &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Items...)
+		if !page.HasNext {
+			break
+		}
+		offset += limit
+	}
+	return all, nil
+}
+
+// flattenFronteggFeature converts f into the attributes of
+// fronteggFeatureDataSourceSchema.
+func flattenFronteggFeature(f fronteggFeatureV2) (map[string]interface{}, error) {
+	permissions := append([]permissionObject(nil), f.Permissions...)
+	sort.Slice(permissions, func(i, j int) bool {
+		return permissions[i].PermissionKey < permissions[j].PermissionKey
+	})
+	flatPermissions := make([]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		flatPermissions = append(flatPermissions, map[string]interface{}{
+			"permission_key": p.PermissionKey,
+			"permission_id":  p.PermissionID,
+		})
+	}
+
+	metadata := ""
+	if len(f.Metadata) > 0 {
+		encoded, err := json.Marshal(f.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("encoding metadata of feature %q: %w", f.Key, err)
+		}
+		metadata = string(encoded)
+	}
+
+	featureFlag := []interface{}{}
+	if f.FeatureFlag != nil {
+		featureFlag = append(featureFlag, map[string]interface{}{
+			"id":                f.FeatureFlag.ID,
+			"name":              f.FeatureFlag.Name,
+			"on":                f.FeatureFlag.On,
+			"default_treatment": f.FeatureFlag.DefaultTreatment,
+			"off_treatment":     f.FeatureFlag.OffTreatment,
+		})
+	}
+
+	return map[string]interface{}{
+		"id":           f.ID,
+		"key":          f.Key,
+		"name":         f.Name,
+		"description":  f.Description,
+		"permissions":  flatPermissions,
+		"metadata":     metadata,
+		"feature_flag": featureFlag,
+		"created_at":   f.CreatedAt,
+		"updated_at":   f.UpdatedAt,
+	}, nil
+}
+
+func dataSourceFronteggFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	key := d.Get("key").(string)
+	features, err := listFronteggFeatures(ctx, &clientHolder.ApiClient, url.Values{"featureKeys": {key}})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var match *fronteggFeatureV2
+	for i := range features {
+		if features[i].Key == key {
+			match = &features[i]
+			break
+		}
+	}
+	if match == nil {
+		return diag.Errorf("unable to find feature with key %q", key)
+	}
+
+	flat, err := flattenFronteggFeature(*match)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(match.ID)
+	for k, v := range flat {
+		if err := d.Set(k, v); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
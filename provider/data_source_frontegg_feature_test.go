@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenFronteggFeature(t *testing.T) {
+	f := fronteggFeatureV2{
+		fronteggFeature: fronteggFeature{
+			ID:       "f-1",
+			Key:      "sso",
+			Name:     "SSO",
+			Metadata: map[string]interface{}{"tier": "enterprise"},
+			FeatureFlag: &featureFlagThin{
+				ID:               "ff-1",
+				Name:             "sso",
+				On:               true,
+				DefaultTreatment: "true",
+				OffTreatment:     "false",
+			},
+		},
+		Permissions: []permissionObject{
+			{PermissionKey: "sso.write", PermissionID: "p-2"},
+			{PermissionKey: "sso.read", PermissionID: "p-1"},
+		},
+	}
+	flat, err := flattenFronteggFeature(f)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantPermissions := []interface{}{
+		map[string]interface{}{"permission_key": "sso.read", "permission_id": "p-1"},
+		map[string]interface{}{"permission_key": "sso.write", "permission_id": "p-2"},
+	}
+	if !reflect.DeepEqual(flat["permissions"], wantPermissions) {
+		t.Errorf("permissions = %v, want %v", flat["permissions"], wantPermissions)
+	}
+	if flat["metadata"] != `{"tier":"enterprise"}` {
+		t.Errorf("metadata = %v", flat["metadata"])
+	}
+	wantFlag := []interface{}{map[string]interface{}{
+		"id": "ff-1", "name": "sso", "on": true, "default_treatment": "true", "off_treatment": "false",
+	}}
+	if !reflect.DeepEqual(flat["feature_flag"], wantFlag) {
+		t.Errorf("feature_flag = %v, want %v", flat["feature_flag"], wantFlag)
+	}
+
+	// The flattened attributes must be accepted by the data source schema.
+	d := dataSourceFronteggFeature().Data(nil)
+	for k, v := range flat {
+		if err := d.Set(k, v); err != nil {
+			t.Errorf("setting %s: %v", k, err)
+		}
+	}
+
+	f.FeatureFlag, f.Metadata = nil, nil
+	if flat, _ := flattenFronteggFeature(f); len(flat["feature_flag"].([]interface{})) != 0 || flat["metadata"] != "" {
+		t.Errorf("expected no feature flag or metadata, got %v", flat)
+	}
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggFeatures() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Frontegg features, optionally filtered by key prefix.",
+		ReadContext: dataSourceFronteggFeaturesRead,
+		Schema: map[string]*schema.Schema{
+			"key_prefix": {
+				Description: "Only include features whose key starts with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ids": {
+				Description: "The IDs of the matching features, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"features": {
+				Description: "The matching features, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Resource{Schema: fronteggFeatureDataSourceSchema()},
+			},
+		},
+	}
+}
+
+func dataSourceFronteggFeaturesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	all, err := listFronteggFeatures(ctx, &clientHolder.ApiClient, url.Values{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	keyPrefix := d.Get("key_prefix").(string)
+	var features []fronteggFeatureV2
+	for _, f := range all {
+		if strings.HasPrefix(f.Key, keyPrefix) {
+			features = append(features, f)
+		}
+	}
+	sort.Slice(features, func(i, j int) bool { return features[i].Key < features[j].Key })
+
+	ids := make([]string, 0, len(features))
+	out := make([]map[string]interface{}, 0, len(features))
+	for _, f := range features {
+		flat, err := flattenFronteggFeature(f)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		ids = append(ids, f.ID)
+		out = append(out, flat)
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("features", out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
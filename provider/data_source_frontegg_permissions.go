@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"path"
+	"regexp"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFronteggPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Frontegg permissions, optionally filtered by category and by a key pattern. The `ids` output can be passed straight to `frontegg_role.permission_ids`.",
+		ReadContext: dataSourceFronteggPermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"category_id": {
+				Description:   "Only include permissions in the category with this ID.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"category_name"},
+			},
+			"category_name": {
+				Description:   "Only include permissions in the category with this name.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"category_id"},
+			},
+			"key_glob": {
+				Description:   "Only include permissions whose key matches this glob, e.g. `billing.*`. `*` matches any sequence of characters, `?` a single character.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"key_regex"},
+			},
+			"key_regex": {
+				Description:   "Only include permissions whose key matches this regular expression (RE2 syntax). The expression is not anchored.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsValidRegExp,
+				ConflictsWith: []string{"key_glob"},
+			},
+			"ids": {
+				Description: "The IDs of the matching permissions, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"keys": {
+				Description: "The keys of the matching permissions, sorted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"permissions": {
+				Description: "The matching permissions, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"id":          {Type: schema.TypeString, Computed: true},
+					"key":         {Type: schema.TypeString, Computed: true},
+					"name":        {Type: schema.TypeString, Computed: true},
+					"description": {Type: schema.TypeString, Computed: true},
+					"category_id": {Type: schema.TypeString, Computed: true},
+				}},
+			},
+		},
+	}
+}
+
+// fronteggPermissionKeyMatcher returns a function reporting whether a key
+// matches glob or re. Empty patterns match every key.
+func fronteggPermissionKeyMatcher(glob, re string) (func(key string) bool, error) {
+	switch {
+	case glob != "":
+		if _, err := path.Match(glob, ""); err != nil {
+			return nil, err
+		}
+		return func(key string) bool {
+			ok, _ := path.Match(glob, key)
+			return ok
+		}, nil
+	case re != "":
+		compiled, err := regexp.Compile(re)
+		if err != nil {
+			return nil, err
+		}
+		return compiled.MatchString, nil
+	default:
+		return func(string) bool { return true }, nil
+	}
+}
+
+// filterFronteggPermissions returns the permissions in categoryID (any
+// category if empty) whose key satisfies match, sorted by key.
+func filterFronteggPermissions(permissions []fronteggPermission, categoryID string, match func(key string) bool) []fronteggPermission {
+	var out []fronteggPermission
+	for _, p := range permissions {
+		if categoryID != "" && p.CategoryID != categoryID {
+			continue
+		}
+		if match(p.Key) {
+			out = append(out, p)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+func dataSourceFronteggPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+
+	match, err := fronteggPermissionKeyMatcher(d.Get("key_glob").(string), d.Get("key_regex").(string))
+	if err != nil {
+		return diag.Errorf("invalid key pattern: %s", err)
+	}
+
+	categoryID := d.Get("category_id").(string)
+	if name := d.Get("category_name").(string); name != "" {
+		var categories []fronteggPermissionCategory
+		if err := clientHolder.ApiClient.Get(ctx, fronteggPermissionCategoryPath, &categories); err != nil {
+			return diag.FromErr(err)
+		}
+		for _, c := range categories {
+			if c.Name == name {
+				categoryID = c.ID
+				break
+			}
+		}
+		if categoryID == "" {
+			return diag.Errorf("unable to find permission category with name %q", name)
+		}
+	}
+
+	var all []fronteggPermission
+	if err := clientHolder.ApiClient.Get(ctx, fronteggPermissionPath, &all); err != nil {
+		return diag.FromErr(err)
+	}
+	permissions := filterFronteggPermissions(all, categoryID, match)
+
+	ids := make([]string, 0, len(permissions))
+	keys := make([]string, 0, len(permissions))
+	out := make([]map[string]interface{}, 0, len(permissions))
+	for _, p := range permissions {
+		ids = append(ids, p.ID)
+		keys = append(keys, p.Key)
+		out = append(out, map[string]interface{}{
+			"id":          p.ID,
+			"key":         p.Key,
+			"name":        p.Name,
+			"description": p.Description,
+			"category_id": p.CategoryID,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("keys", keys); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("permissions", out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterFronteggPermissions(t *testing.T) {
+	permissions := []fronteggPermission{
+		{ID: "4", Key: "users.read", CategoryID: "c-users"},
+		{ID: "2", Key: "billing.invoices.write", CategoryID: "c-billing"},
+		{ID: "1", Key: "billing.invoices.read", CategoryID: "c-billing"},
+		{ID: "3", Key: "billing.read", CategoryID: "c-legacy"},
+	}
+	ids := func(permissions []fronteggPermission) []string {
+		var out []string
+		for _, p := range permissions {
+			out = append(out, p.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		categoryID string
+		glob       string
+		regex      string
+		want       []string
+	}{
+		{"no filters sorts by key", "", "", "", []string{"1", "2", "3", "4"}},
+		{"glob spans dots", "", "billing.*", "", []string{"1", "2", "3"}},
+		{"glob single character", "", "users.rea?", "", []string{"4"}},
+		{"regex is unanchored", "", "", `\.read$`, []string{"1", "3", "4"}},
+		{"category", "c-billing", "", "", []string{"1", "2"}},
+		{"category and pattern", "c-billing", "*.write", "", []string{"2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := fronteggPermissionKeyMatcher(tt.glob, tt.regex)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got := ids(filterFronteggPermissions(permissions, tt.categoryID, match))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterFronteggPermissions() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFronteggPermissionKeyMatcherInvalid(t *testing.T) {
+	if _, err := fronteggPermissionKeyMatcher("billing.[", ""); err == nil {
+		t.Error("expected an error for an invalid glob")
+	}
+	if _, err := fronteggPermissionKeyMatcher("", "billing.("); err == nil {
+		t.Error("expected an error for an invalid regex")
+	}
+}
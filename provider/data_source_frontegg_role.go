@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggRole() *schema.Resource {
+	s := resourceFronteggRole().Schema
+	for _, field := range s {
+		field.Required = false
+		field.Optional = false
+		field.Computed = true
+	}
+	// Roles are matched by key or name; tenant_id scopes the lookup to roles
+	// owned by that tenant, as on the resource.
+	s["key"].Optional = true
+	s["key"].ExactlyOneOf = []string{"key", "name"}
+	s["name"].Optional = true
+	s["name"].ExactlyOneOf = []string{"key", "name"}
+	s["tenant_id"].Optional = true
+	return &schema.Resource{
+		Description: "Looks up a Frontegg role by key or name and exposes its ID, permissions and flags, so roles can be assigned without hard-coding their IDs.",
+		ReadContext: dataSourceFronteggRoleRead,
+		Schema:      s,
+	}
+}
+
+func dataSourceFronteggRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	var out []fronteggRole
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fronteggRolePath, getTenantIdHeaders(d), &out); err != nil {
+		return diag.FromErr(err)
+	}
+	key := d.Get("key").(string)
+	name := d.Get("name").(string)
+	var match *fronteggRole
+	for i, role := range out {
+		if (key != "" && role.Key == key) || (key == "" && role.Name == name) {
+			if match != nil {
+				return diag.Errorf("found more than one role named %q; look it up by key instead", name)
+			}
+			match = &out[i]
+		}
+	}
+	if match == nil {
+		if key != "" {
+			return diag.Errorf("unable to find role with key %q", key)
+		}
+		return diag.Errorf("unable to find role with name %q", name)
+	}
+	if err := resourceFronteggRoleDeserialize(d, *match); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
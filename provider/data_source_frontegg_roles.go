@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggRoles() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Frontegg roles, optionally filtered by key prefix and by the permissions they confer.",
+		ReadContext: dataSourceFronteggRolesRead,
+		Schema: map[string]*schema.Schema{
+			"key_prefix": {
+				Description: "Only include roles whose key starts with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"permission_ids": {
+				Description: "Only include roles that confer all of these permission IDs.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenant_id": {
+				Description: "List the roles of this tenant instead of the environment-wide roles.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"ids": {
+				Description: "The IDs of the matching roles, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": {
+				Description: "The matching roles, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"id":             {Type: schema.TypeString, Computed: true},
+					"key":            {Type: schema.TypeString, Computed: true},
+					"name":           {Type: schema.TypeString, Computed: true},
+					"description":    {Type: schema.TypeString, Computed: true},
+					"level":          {Type: schema.TypeInt, Computed: true},
+					"default":        {Type: schema.TypeBool, Computed: true},
+					"first_user":     {Type: schema.TypeBool, Computed: true},
+					"permission_ids": {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"tenant_id":      {Type: schema.TypeString, Computed: true},
+				}},
+			},
+		},
+	}
+}
+
+// filterFronteggRoles returns the roles whose key starts with keyPrefix and
+// that confer every one of permissionIDs, sorted by key.
+func filterFronteggRoles(roles []fronteggRole, keyPrefix string, permissionIDs []string) []fronteggRole {
+	var out []fronteggRole
+	for _, role := range roles {
+		if !strings.HasPrefix(role.Key, keyPrefix) {
+			continue
+		}
+		hasAll := true
+		for _, permissionID := range permissionIDs {
+			if !stringInSlice(permissionID, role.Permissions) {
+				hasAll = false
+				break
+			}
+		}
+		if hasAll {
+			out = append(out, role)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
+
+func dataSourceFronteggRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	var all []fronteggRole
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fronteggRolePath, getTenantIdHeaders(d), &all); err != nil {
+		return diag.FromErr(err)
+	}
+
+	roles := filterFronteggRoles(
+		all,
+		d.Get("key_prefix").(string),
+		stringSetToList(d.Get("permission_ids").(*schema.Set)),
+	)
+
+	ids := make([]string, 0, len(roles))
+	out := make([]map[string]interface{}, 0, len(roles))
+	for _, role := range roles {
+		ids = append(ids, role.ID)
+		out = append(out, map[string]interface{}{
+			"id":             role.ID,
+			"key":            role.Key,
+			"name":           role.Name,
+			"description":    role.Description,
+			"level":          role.Level,
+			"default":        role.IsDefault,
+			"first_user":     role.FirstUserRole,
+			"permission_ids": role.Permissions,
+			"tenant_id":      role.TenantID,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("roles", out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFilterFronteggRoles(t *testing.T) {
+	roles := []fronteggRole{
+		{ID: "3", Key: "billing.viewer", Permissions: []string{"p-read"}},
+		{ID: "1", Key: "admin", Permissions: []string{"p-read", "p-write"}},
+		{ID: "2", Key: "billing.admin", Permissions: []string{"p-read", "p-write"}},
+	}
+	ids := func(roles []fronteggRole) []string {
+		var out []string
+		for _, r := range roles {
+			out = append(out, r.ID)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name          string
+		keyPrefix     string
+		permissionIDs []string
+		want          []string
+	}{
+		{"no filters sorts by key", "", nil, []string{"1", "2", "3"}},
+		{"key prefix", "billing.", nil, []string{"2", "3"}},
+		{"all permissions required", "", []string{"p-read", "p-write"}, []string{"1", "2"}},
+		{"both filters", "billing.", []string{"p-write"}, []string{"2"}},
+		{"no match", "support.", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(filterFronteggRoles(roles, tt.keyPrefix, tt.permissionIDs))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterFronteggRoles() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
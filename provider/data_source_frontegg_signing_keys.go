@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const fronteggJWKSPath = "/.well-known/jwks.json"
+
+// errFronteggJWKUnsupported marks keys of a type or curve that cannot be
+// converted to PEM. They are skipped rather than failing the read.
+var errFronteggJWKUnsupported = errors.New("unsupported key")
+
+type fronteggJWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg,omitempty"`
+	Use string `json:"use,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+type fronteggJWKS struct {
+	Keys []json.RawMessage `json:"keys"`
+}
+
+func dataSourceFronteggSigningKeys() *schema.Resource {
+	return &schema.Resource{
+		Description: `Reads the public keys Frontegg signs JWTs with from the environment's JWKS, for pinning in API gateways.
+Keys follow rotations on every refresh. Templates created with ` + "`frontegg_jwt_template`" + ` using ` + "`RS256`" + ` are
+signed with these keys; ` + "`HS256`" + ` templates use a shared secret that is never published.`,
+		ReadContext: dataSourceFronteggSigningKeysRead,
+		Schema: map[string]*schema.Schema{
+			"host": {
+				Description: "The host serving the JWKS, such as a custom domain. Defaults to the workspace's Frontegg domain.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"algorithm": {
+				Description:  "Only include keys for this JWT template algorithm.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(fronteggJWTTemplateAlgorithms, false),
+			},
+			"jwks_url": {
+				Description: "The URL the keys were read from.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"kids": {
+				Description: "The key IDs of the signing keys, in JWKS order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"keys": {
+				Description: "The signing keys, in JWKS order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"kid": {
+						Description: "The key ID, matching the `kid` header of tokens signed with it.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"alg": {
+						Description: "The signing algorithm, e.g. `RS256`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"kty": {
+						Description: "The key type, e.g. `RSA`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"use": {
+						Description: "The intended use of the key, usually `sig`.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"pem": {
+						Description: "The public key in PEM-encoded PKIX form.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+					"jwk": {
+						Description: "The key as a JWK JSON document, as published.",
+						Type:        schema.TypeString,
+						Computed:    true,
+					},
+				}},
+			},
+		},
+	}
+}
+
+// fronteggJWKAlgorithm returns the algorithm of k, defaulting RSA keys that do
+// not declare one to RS256, the algorithm Frontegg signs RSA tokens with.
+func fronteggJWKAlgorithm(k fronteggJWK) string {
+	if k.Alg == "" && k.Kty == "RSA" {
+		return "RS256"
+	}
+	return k.Alg
+}
+
+// fronteggJWKPublicKeyPEM converts an RSA or EC public JWK to PEM.
+func fronteggJWKPublicKeyPEM(k fronteggJWK) (string, error) {
+	decode := func(field, value string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+		if err != nil || len(b) == 0 {
+			return nil, fmt.Errorf("key %q: invalid %s", k.Kid, field)
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+
+	var pub interface{}
+	switch k.Kty {
+	case "RSA":
+		n, err := decode("n", k.N)
+		if err != nil {
+			return "", err
+		}
+		e, err := decode("e", k.E)
+		if err != nil {
+			return "", err
+		}
+		pub = &rsa.PublicKey{N: n, E: int(e.Int64())}
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return "", fmt.Errorf("key %q: %w: curve %q", k.Kid, errFronteggJWKUnsupported, k.Crv)
+		}
+		x, err := decode("x", k.X)
+		if err != nil {
+			return "", err
+		}
+		y, err := decode("y", k.Y)
+		if err != nil {
+			return "", err
+		}
+		pub = &ecdsa.PublicKey{Curve: curve, X: x, Y: y}
+	default:
+		return "", fmt.Errorf("key %q: %w: type %q", k.Kid, errFronteggJWKUnsupported, k.Kty)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", fmt.Errorf("key %q: %w", k.Kid, err)
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+// flattenFronteggJWKS converts the keys of a JWKS into the keys attribute,
+// keeping only those for algorithm if it is set. Keys of an unsupported type
+// are skipped with a warning so that a rotation to a new key type does not
+// break reads.
+func flattenFronteggJWKS(jwks fronteggJWKS, algorithm string) ([]map[string]interface{}, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := make([]map[string]interface{}, 0, len(jwks.Keys))
+	for _, raw := range jwks.Keys {
+		var k fronteggJWK
+		if err := json.Unmarshal(raw, &k); err != nil {
+			return nil, diag.Errorf("decoding JWKS key: %s", err)
+		}
+		alg := fronteggJWKAlgorithm(k)
+		if algorithm != "" && alg != algorithm {
+			continue
+		}
+		publicKeyPEM, err := fronteggJWKPublicKeyPEM(k)
+		if errors.Is(err, errFronteggJWKUnsupported) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Skipping unsupported signing key",
+				Detail:   err.Error(),
+			})
+			continue
+		}
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, raw); err != nil {
+			return nil, diag.FromErr(err)
+		}
+		out = append(out, map[string]interface{}{
+			"kid": k.Kid,
+			"alg": alg,
+			"kty": k.Kty,
+			"use": k.Use,
+			"pem": publicKeyPEM,
+			"jwk": compact.String(),
+		})
+	}
+	return out, diags
+}
+
+func dataSourceFronteggSigningKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+
+	host := d.Get("host").(string)
+	if host == "" {
+		vendor, err := fetchFronteggVendor(ctx, clientHolder)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		host = vendor.Host
+	}
+	baseURL := strings.TrimRight(host, "/")
+	if !strings.Contains(baseURL, "://") {
+		baseURL = "https://" + baseURL
+	}
+
+	// The JWKS is public and served by the vendor host, not the management API.
+	jwksClient := restclient.MakeRestClient(baseURL, "", "")
+	var jwks fronteggJWKS
+	if err := jwksClient.Get(ctx, fronteggJWKSPath, &jwks); err != nil {
+		return diag.FromErr(err)
+	}
+
+	algorithm := d.Get("algorithm").(string)
+	keys, diags := flattenFronteggJWKS(jwks, algorithm)
+	if diags.HasError() {
+		return diags
+	}
+	kids := make([]string, 0, len(keys))
+	for _, k := range keys {
+		kids = append(kids, k["kid"].(string))
+	}
+
+	d.SetId(baseURL + fronteggJWKSPath)
+	if err := d.Set("host", host); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("jwks_url", baseURL+fronteggJWKSPath); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("kids", kids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("keys", keys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if algorithm == "HS256" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "HS256 keys are not published",
+			Detail:   "Tokens from JWT templates using HS256 are signed with a shared secret, which Frontegg does not publish in the JWKS. Verify them with the secret instead.",
+		})
+	}
+	return diags
+}
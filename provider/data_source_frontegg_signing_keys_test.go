@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestFlattenFronteggJWKS(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b64 := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+	jwks := fronteggJWKS{Keys: []json.RawMessage{
+		// Frontegg omits alg on its RSA keys.
+		json.RawMessage(fmt.Sprintf(`{"kty": "RSA", "kid": "rsa-1", "use": "sig", "n": %q, "e": %q}`,
+			b64(rsaKey.N.Bytes()), b64(big.NewInt(int64(rsaKey.E)).Bytes()))),
+		json.RawMessage(fmt.Sprintf(`{"kty":"EC","kid":"ec-1","alg":"ES256","crv":"P-256","x":%q,"y":%q}`,
+			b64(ecKey.X.FillBytes(make([]byte, 32))), b64(ecKey.Y.FillBytes(make([]byte, 32))))),
+	}}
+
+	keys, diags := flattenFronteggJWKS(jwks, "")
+	if len(diags) != 0 {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(keys) != 2 {
+		t.Fatalf("expected 2 keys, got %d", len(keys))
+	}
+	if keys[0]["alg"] != "RS256" || keys[0]["kid"] != "rsa-1" || keys[0]["use"] != "sig" {
+		t.Errorf("unexpected RSA key attributes: %v", keys[0])
+	}
+	if keys[0]["jwk"] == string(jwks.Keys[0]) || !json.Valid([]byte(keys[0]["jwk"].(string))) {
+		t.Errorf("expected compact JWK JSON, got %q", keys[0]["jwk"])
+	}
+
+	for i, want := range []interface{ Equal(crypto.PublicKey) bool }{&rsaKey.PublicKey, &ecKey.PublicKey} {
+		block, _ := pem.Decode([]byte(keys[i]["pem"].(string)))
+		if block == nil || block.Type != "PUBLIC KEY" {
+			t.Fatalf("key %d: expected a PUBLIC KEY PEM block, got %q", i, keys[i]["pem"])
+		}
+		got, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			t.Fatalf("key %d: %v", i, err)
+		}
+		if !want.Equal(got) {
+			t.Errorf("key %d: PEM does not round-trip to the original public key", i)
+		}
+	}
+
+	rs256, diags := flattenFronteggJWKS(jwks, "RS256")
+	if len(diags) != 0 || len(rs256) != 1 || rs256[0]["kid"] != "rsa-1" {
+		t.Errorf("expected only the RSA key for RS256, got %v, %v", rs256, diags)
+	}
+
+	// A key of an unsupported type is skipped with a warning.
+	withOKP := fronteggJWKS{Keys: append([]json.RawMessage{
+		json.RawMessage(`{"kty":"OKP","kid":"ed-1","alg":"EdDSA","crv":"Ed25519","x":"AQ"}`),
+	}, jwks.Keys...)}
+	skipped, diags := flattenFronteggJWKS(withOKP, "")
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning || len(skipped) != 2 {
+		t.Errorf("expected the OKP key to be skipped with a warning, got %v, %v", skipped, diags)
+	}
+	if _, diags := flattenFronteggJWKS(fronteggJWKS{Keys: []json.RawMessage{json.RawMessage(`{"kty":"RSA","kid":"bad","e":"AQAB"}`)}}, ""); !diags.HasError() {
+		t.Error("expected a malformed RSA key to fail the read")
+	}
+
+	// Keys must fit the data source schema.
+	d := dataSourceFronteggSigningKeys().Data(nil)
+	if err := d.Set("keys", keys); err != nil {
+		t.Errorf("keys do not match the schema: %v", err)
+	}
+}
+
+func TestFronteggJWKPublicKeyPEMInvalid(t *testing.T) {
+	for name, k := range map[string]fronteggJWK{
+		"unsupported type":  {Kid: "a", Kty: "oct"},
+		"missing modulus":   {Kid: "b", Kty: "RSA", E: "AQAB"},
+		"unsupported curve": {Kid: "c", Kty: "EC", Crv: "secp256k1", X: "AQ", Y: "AQ"},
+	} {
+		if _, err := fronteggJWKPublicKeyPEM(k); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
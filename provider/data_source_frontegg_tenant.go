@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggTenant() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up a Frontegg tenant by key or name, including tenants not managed by Terraform such as those created by signup.",
+		ReadContext: dataSourceFronteggTenantRead,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Description:  "The identifier of the tenant to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"key", "name"},
+			},
+			"name": {
+				Description:  "The name of the tenant to look up. The lookup fails if more than one tenant has this name.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"key", "name"},
+			},
+			"application_uri": {
+				Description: "The application URI for this tenant.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "All metadata of the tenant. Values that are not strings are JSON-encoded.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceFronteggTenantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	key := d.Get("key").(string)
+	name := d.Get("name").(string)
+
+	var match *fronteggTenant
+	if key != "" {
+		var out []fronteggTenant
+		if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/%s", fronteggTenantPath, url.PathEscape(key)), &out); err != nil {
+			if !restclient.IsNotFound(err) {
+				return diag.FromErr(err)
+			}
+		}
+		for i := range out {
+			if out[i].Key == key {
+				match = &out[i]
+				break
+			}
+		}
+		if match == nil {
+			return diag.Errorf("unable to find tenant with key %q", key)
+		}
+	} else {
+		tenants, err := listFronteggTenants(ctx, &clientHolder.ApiClient, url.Values{"_filter": {name}})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for i, tenant := range tenants {
+			if tenant.Name != name {
+				continue
+			}
+			if match != nil {
+				return diag.Errorf("found more than one tenant named %q; look it up by key instead", name)
+			}
+			match = &tenants[i]
+		}
+		if match == nil {
+			return diag.Errorf("unable to find tenant with name %q", name)
+		}
+	}
+
+	metadata, err := decodeFronteggMetadata(match.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(match.Key)
+	if err := d.Set("key", match.Key); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", match.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("application_uri", match.ApplicationUri); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
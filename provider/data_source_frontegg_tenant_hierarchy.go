@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type fronteggTenantHierarchyNode struct {
+	TenantID string                        `json:"tenantId"`
+	Name     string                        `json:"name"`
+	Children []fronteggTenantHierarchyNode `json:"children"`
+}
+
+func dataSourceFronteggTenantHierarchy() *schema.Resource {
+	return &schema.Resource{
+		Description: `Returns the tree of sub-tenants under a root tenant, flattened depth-first, so per-customer modules
+can build resources for each sub-account.`,
+
+		ReadContext: dataSourceFronteggTenantHierarchyRead,
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The key of the root tenant.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"ids": {
+				Description: "The keys of the root tenant and all of its descendants, depth-first.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenants": {
+				Description: "The root tenant and all of its descendants, depth-first with siblings sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Description: "The key of the tenant.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the tenant.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"parent_tenant_id": {
+							Description: "The key of the tenant's parent, or empty for the root tenant.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"depth": {
+							Description: "The distance from the root tenant, which has depth 0.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenFronteggTenantHierarchy walks the tree depth-first, visiting siblings
+// in key order.
+func flattenFronteggTenantHierarchy(root fronteggTenantHierarchyNode) []map[string]interface{} {
+	var out []map[string]interface{}
+	var walk func(node fronteggTenantHierarchyNode, parent string, depth int)
+	walk = func(node fronteggTenantHierarchyNode, parent string, depth int) {
+		out = append(out, map[string]interface{}{
+			"key":              node.TenantID,
+			"name":             node.Name,
+			"parent_tenant_id": parent,
+			"depth":            depth,
+		})
+		children := append([]fronteggTenantHierarchyNode(nil), node.Children...)
+		sort.Slice(children, func(i, j int) bool { return children[i].TenantID < children[j].TenantID })
+		for _, child := range children {
+			walk(child, node.TenantID, depth+1)
+		}
+	}
+	walk(root, "", 0)
+	return out
+}
+
+func dataSourceFronteggTenantHierarchyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	tenantID := d.Get("tenant_id").(string)
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+
+	var root fronteggTenantHierarchyNode
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fronteggTenantHierarchyPath+"/tree", headers, &root); err != nil {
+		if restclient.IsNotFound(err) {
+			return diag.Errorf("tenant %q not found", tenantID)
+		}
+		return diag.FromErr(err)
+	}
+	if root.TenantID == "" {
+		root.TenantID = tenantID
+	}
+	if root.TenantID != tenantID {
+		return diag.FromErr(fmt.Errorf("hierarchy for tenant %q returned root %q", tenantID, root.TenantID))
+	}
+
+	tenants := flattenFronteggTenantHierarchy(root)
+	ids := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		ids = append(ids, tenant["key"].(string))
+	}
+
+	d.SetId(tenantID)
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenants", tenants); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenFronteggTenantHierarchy(t *testing.T) {
+	root := fronteggTenantHierarchyNode{
+		TenantID: "reseller",
+		Name:     "Reseller",
+		Children: []fronteggTenantHierarchyNode{
+			{TenantID: "customer-b", Name: "Customer B"},
+			{
+				TenantID: "customer-a",
+				Name:     "Customer A",
+				Children: []fronteggTenantHierarchyNode{{TenantID: "customer-a-eu", Name: "Customer A (EU)"}},
+			},
+		},
+	}
+	want := []map[string]interface{}{
+		{"key": "reseller", "name": "Reseller", "parent_tenant_id": "", "depth": 0},
+		{"key": "customer-a", "name": "Customer A", "parent_tenant_id": "reseller", "depth": 1},
+		{"key": "customer-a-eu", "name": "Customer A (EU)", "parent_tenant_id": "customer-a", "depth": 2},
+		{"key": "customer-b", "name": "Customer B", "parent_tenant_id": "reseller", "depth": 1},
+	}
+	if got := flattenFronteggTenantHierarchy(root); !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenFronteggTenantHierarchy() =\n%v\nwant\n%v", got, want)
+	}
+}
+
+func TestTenantParentChangeRequiresReplacement(t *testing.T) {
+	cases := []struct {
+		before, after string
+		want          bool
+	}{
+		{"", "", false},
+		{"reseller-a", "reseller-b", false},
+		{"", "reseller-a", true},
+		{"reseller-a", "", true},
+	}
+	for _, c := range cases {
+		if got := tenantParentChangeRequiresReplacement(c.before, c.after); got != c.want {
+			t.Errorf("tenantParentChangeRequiresReplacement(%q, %q) = %v, want %v", c.before, c.after, got, c.want)
+		}
+	}
+}
+
+func TestResourceFronteggTenantDeserializeParent(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceFronteggTenant().Schema, map[string]interface{}{
+		"key":  "customer-a",
+		"name": "Customer A",
+	})
+	d.SetId("customer-a")
+	if err := d.Set("parent_tenant_id", "reseller"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A response without the parent keeps the one in state.
+	if err := resourceFronteggTenantDeserialize(d, fronteggTenant{Key: "customer-a", Name: "Customer A"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("parent_tenant_id"); got != "reseller" {
+		t.Errorf("expected the parent to be kept, got %q", got)
+	}
+
+	moved := "reseller-b"
+	if err := resourceFronteggTenantDeserialize(d, fronteggTenant{Key: "customer-a", Name: "Customer A", ParentTenantID: &moved}); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("parent_tenant_id"); got != moved {
+		t.Errorf("expected the parent from the response, got %q", got)
+	}
+
+	// Leaving the parent unset must not plan a change, or existing
+	// sub-tenants would be replaced.
+	if s := resourceFronteggTenant().Schema["parent_tenant_id"]; !s.Optional || !s.Computed {
+		t.Error("parent_tenant_id must be optional and computed")
+	}
+}
@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggTenantSSOSPMetadata() *schema.Resource {
+	return &schema.Resource{
+		Description: `Exposes the Frontegg service provider (SP) values of a tenant's SAML configuration, for registering
+Frontegg as an application in the customer's identity provider, e.g. with the Okta or Azure AD providers.
+
+**Note:** The Frontegg API does not expose the certificate Frontegg signs SAML requests with, so ` + "`metadata_xml`" + `
+carries no signing key. Identity providers that verify signed requests need that certificate copied from the
+Frontegg portal.`,
+		ReadContext: dataSourceFronteggTenantSSOSPMetadataRead,
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant that owns the SSO configuration.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"config_id": {
+				Description: "The ID of the SAML configuration, e.g. `frontegg_tenant_saml_config.example.id`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"acs_url": {
+				Description: "The assertion consumer service URL the identity provider posts SAML responses to.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"sp_entity_id": {
+				Description: "The entity ID (audience) of Frontegg as a service provider.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"metadata_xml": {
+				Description: "SAML 2.0 SP metadata built from the values above, for identity providers that import metadata.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+type samlAssertionConsumerService struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+	Index    int    `xml:"index,attr"`
+}
+
+type samlSPSSODescriptor struct {
+	AuthnRequestsSigned        bool                         `xml:"AuthnRequestsSigned,attr"`
+	WantAssertionsSigned       bool                         `xml:"WantAssertionsSigned,attr"`
+	ProtocolSupportEnumeration string                       `xml:"protocolSupportEnumeration,attr"`
+	NameIDFormat               string                       `xml:"md:NameIDFormat"`
+	AssertionConsumerService   samlAssertionConsumerService `xml:"md:AssertionConsumerService"`
+}
+
+type samlEntityDescriptor struct {
+	XMLName         xml.Name            `xml:"md:EntityDescriptor"`
+	XMLNSMD         string              `xml:"xmlns:md,attr"`
+	EntityID        string              `xml:"entityID,attr"`
+	SPSSODescriptor samlSPSSODescriptor `xml:"md:SPSSODescriptor"`
+}
+
+// fronteggSPMetadataXML builds SAML 2.0 SP metadata for Frontegg. It has no
+// signing key, since the API does not expose Frontegg's SP certificate.
+func fronteggSPMetadataXML(entityID, acsURL string) (string, error) {
+	descriptor := samlEntityDescriptor{
+		XMLNSMD:  "urn:oasis:names:tc:SAML:2.0:metadata",
+		EntityID: entityID,
+		SPSSODescriptor: samlSPSSODescriptor{
+			AuthnRequestsSigned:        false,
+			WantAssertionsSigned:       true,
+			ProtocolSupportEnumeration: "urn:oasis:names:tc:SAML:2.0:protocol",
+			NameIDFormat:               "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+			AssertionConsumerService: samlAssertionConsumerService{
+				Binding:  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST",
+				Location: acsURL,
+				Index:    0,
+			},
+		},
+	}
+	out, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(out) + "\n", nil
+}
+
+func dataSourceFronteggTenantSSOSPMetadataRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	tenantID := d.Get("tenant_id").(string)
+	configID := d.Get("config_id").(string)
+	headers, err := tenantSSOHeaders(tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var configs []fronteggTenantSSOConfig
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fronteggTenantSSOConfigPath, headers, &configs); err != nil {
+		return diag.FromErr(err)
+	}
+	var config *fronteggTenantSSOConfig
+	for i := range configs {
+		if configs[i].ID == configID {
+			config = &configs[i]
+			break
+		}
+	}
+	if config == nil {
+		return diag.Errorf("unable to find SSO configuration %q in tenant %q", configID, tenantID)
+	}
+	if config.Type != "" && config.Type != "saml" {
+		return diag.Errorf("SSO configuration %q is of type %q; SP metadata is only available for SAML configurations", configID, config.Type)
+	}
+
+	// Configurations that do not override the ACS URL or entity ID use the
+	// workspace-wide SAML settings.
+	acsURL, spEntityID := config.ACSUrl, config.SPEntityID
+	if acsURL == "" || spEntityID == "" {
+		saml, err := fetchFronteggSSOSAML(ctx, clientHolder)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if saml != nil {
+			if acsURL == "" {
+				acsURL = saml.Configuration.ACSUrl
+			}
+			if spEntityID == "" {
+				spEntityID = saml.Configuration.SPEntityID
+			}
+		}
+	}
+
+	metadataXML, err := fronteggSPMetadataXML(spEntityID, acsURL)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", tenantID, configID))
+	if err := d.Set("acs_url", acsURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sp_entity_id", spEntityID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata_xml", metadataXML); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
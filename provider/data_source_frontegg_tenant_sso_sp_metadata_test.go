@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestFronteggSPMetadataXML(t *testing.T) {
+	out, err := fronteggSPMetadataXML("https://auth.example.com", "https://auth.example.com/auth/saml/callback")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		EntityID string `xml:"entityID,attr"`
+		SP       struct {
+			AuthnRequestsSigned  bool `xml:"AuthnRequestsSigned,attr"`
+			WantAssertionsSigned bool `xml:"WantAssertionsSigned,attr"`
+			ACS                  struct {
+				Location string `xml:"Location,attr"`
+			} `xml:"AssertionConsumerService"`
+		} `xml:"SPSSODescriptor"`
+	}
+	if err := xml.Unmarshal([]byte(out), &parsed); err != nil {
+		t.Fatalf("metadata is not valid XML: %v\n%s", err, out)
+	}
+	if parsed.EntityID != "https://auth.example.com" ||
+		parsed.SP.ACS.Location != "https://auth.example.com/auth/saml/callback" ||
+		parsed.SP.AuthnRequestsSigned ||
+		!parsed.SP.WantAssertionsSigned {
+		t.Errorf("unexpected metadata: %+v\n%s", parsed, out)
+	}
+	if strings.Contains(out, "KeyDescriptor") {
+		t.Errorf("expected no signing key in the metadata:\n%s", out)
+	}
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type fronteggTenantListPage struct {
+	Items    []fronteggTenant `json:"items"`
+	Metadata struct {
+		TotalItems *int `json:"totalItems"`
+	} `json:"_metadata"`
+}
+
+func dataSourceFronteggTenants() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Frontegg tenants, optionally filtered by name prefix and metadata, so per-tenant resources can be created across existing customers.",
+		ReadContext: dataSourceFronteggTenantsRead,
+		Schema: map[string]*schema.Schema{
+			"name_prefix": {
+				Description: "Only include tenants whose name starts with this prefix.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"selected_metadata": {
+				Description: "Only include tenants whose metadata contains all of these keys with exactly these values.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ids": {
+				Description: "The keys of the matching tenants, sorted.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"tenants": {
+				Description: "The matching tenants, sorted by key.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"key":             {Type: schema.TypeString, Computed: true},
+					"name":            {Type: schema.TypeString, Computed: true},
+					"application_uri": {Type: schema.TypeString, Computed: true},
+					"metadata":        {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				}},
+			},
+		},
+	}
+}
+
+// listFronteggTenants pages through every tenant matching filters.
+func listFronteggTenants(ctx context.Context, c *restclient.Client, filters url.Values) ([]fronteggTenant, error) {
+	const limit = 100
+	offset := 0
+	var all []fronteggTenant
+	for {
+		q := url.Values{}
+		for k, vs := range filters {
+			for _, v := range vs {
+				q.Add(k, v)
+			}
+		}
+		q.Set("_offset", fmt.Sprintf("%d", offset))
+		q.Set("_limit", fmt.Sprintf("%d", limit))
+
+		var page fronteggTenantListPage
+		if err := c.Get(ctx, fronteggTenantPathV2+"?"+q.Encode(), &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Items...)
+		offset += limit
+		// A short page is the last one. totalItems only ends paging early
+		// when the response carries it.
+		if len(page.Items) < limit {
+			break
+		}
+		if total := page.Metadata.TotalItems; total != nil && offset >= *total {
+			break
+		}
+	}
+	return all, nil
+}
+
+// filterFronteggTenants returns the tenants whose name starts with namePrefix
+// and whose decoded metadata contains every entry of selectedMetadata, sorted
+// by key, along with the decoded metadata of each.
+func filterFronteggTenants(tenants []fronteggTenant, namePrefix string, selectedMetadata map[string]string) ([]fronteggTenant, []map[string]string, error) {
+	sorted := append([]fronteggTenant(nil), tenants...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Key < sorted[j].Key })
+
+	var out []fronteggTenant
+	var metadata []map[string]string
+	for _, tenant := range sorted {
+		if !strings.HasPrefix(tenant.Name, namePrefix) {
+			continue
+		}
+		decoded, err := decodeFronteggMetadata(tenant.Metadata)
+		if err != nil {
+			return nil, nil, fmt.Errorf("tenant %q: %w", tenant.Key, err)
+		}
+		matches := true
+		for key, value := range selectedMetadata {
+			if got, ok := decoded[key]; !ok || got != value {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			out = append(out, tenant)
+			metadata = append(metadata, decoded)
+		}
+	}
+	return out, metadata, nil
+}
+
+func dataSourceFronteggTenantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	all, err := listFronteggTenants(ctx, &clientHolder.ApiClient, url.Values{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tenants, metadata, err := filterFronteggTenants(
+		all,
+		d.Get("name_prefix").(string),
+		castResourceStringMap(d.Get("selected_metadata")),
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ids := make([]string, 0, len(tenants))
+	out := make([]map[string]interface{}, 0, len(tenants))
+	for i, tenant := range tenants {
+		ids = append(ids, tenant.Key)
+		out = append(out, map[string]interface{}{
+			"key":             tenant.Key,
+			"name":            tenant.Name,
+			"application_uri": tenant.ApplicationUri,
+			"metadata":        metadata[i],
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tenants", out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+)
+
+func TestDecodeFronteggTenantMetadata(t *testing.T) {
+	got, err := decodeFronteggMetadata(`{"plan":"enterprise","seats":25,"flags":{"beta":true}}`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]string{"plan": "enterprise", "seats": "25", "flags": `{"beta":true}`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("decodeFronteggMetadata() = %v, want %v", got, want)
+	}
+
+	if got, err := decodeFronteggMetadata(""); err != nil || len(got) != 0 {
+		t.Errorf("expected empty metadata to decode to an empty map, got %v, %v", got, err)
+	}
+	if _, err := decodeFronteggMetadata("not json"); err == nil {
+		t.Error("expected an error for invalid metadata")
+	}
+}
+
+func TestFilterFronteggTenants(t *testing.T) {
+	tenants := []fronteggTenant{
+		{Key: "c", Name: "Acme West", Metadata: `{"plan":"enterprise","region":"us"}`},
+		{Key: "a", Name: "Acme East", Metadata: `{"plan":"enterprise","region":"eu"}`},
+		{Key: "b", Name: "Globex", Metadata: `{"plan":"free"}`},
+		{Key: "d", Name: "Acme Labs"},
+	}
+	keys := func(tenants []fronteggTenant) []string {
+		var out []string
+		for _, tenant := range tenants {
+			out = append(out, tenant.Key)
+		}
+		return out
+	}
+
+	tests := []struct {
+		name       string
+		namePrefix string
+		metadata   map[string]string
+		want       []string
+	}{
+		{"no filters sorts by key", "", nil, []string{"a", "b", "c", "d"}},
+		{"name prefix", "Acme", nil, []string{"a", "c", "d"}},
+		{"metadata match", "", map[string]string{"plan": "enterprise"}, []string{"a", "c"}},
+		{"all metadata entries required", "", map[string]string{"plan": "enterprise", "region": "eu"}, []string{"a"}},
+		{"both filters", "Glo", map[string]string{"plan": "free"}, []string{"b"}},
+		{"no match", "", map[string]string{"plan": "trial"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, metadata, err := filterFronteggTenants(tenants, tt.namePrefix, tt.metadata)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(keys(got), tt.want) {
+				t.Errorf("filterFronteggTenants() = %v, want %v", keys(got), tt.want)
+			}
+			if len(metadata) != len(got) {
+				t.Errorf("got %d metadata maps for %d tenants", len(metadata), len(got))
+			}
+		})
+	}
+}
+
+func TestListFronteggTenantsPagesWithoutMetadata(t *testing.T) {
+	const total = 250
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("_offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("_limit"))
+		items := []fronteggTenant{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			items = append(items, fronteggTenant{Key: fmt.Sprintf("t%d", i)})
+		}
+		// No _metadata in the response.
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"items": items})
+	}))
+	defer srv.Close()
+
+	client := restclient.MakeRestClient(srv.URL, "", "")
+	client.Authenticate("test-token")
+
+	tenants, err := listFronteggTenants(context.Background(), &client, url.Values{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(tenants) != total {
+		t.Errorf("got %d tenants, want %d", len(tenants), total)
+	}
+	if requests != 3 {
+		t.Errorf("got %d requests, want 3", requests)
+	}
+}
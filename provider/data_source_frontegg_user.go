@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Looks up a Frontegg user by email or ID and exposes the roles they hold in a tenant.",
+		ReadContext: dataSourceFronteggUserRead,
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Description:  "The email address of the user to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"email", "user_id"},
+			},
+			"user_id": {
+				Description:  "The ID of the user to look up.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"email", "user_id"},
+			},
+			"tenant_id": {
+				Description: "The tenant whose roles are reported for the user.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"name": {
+				Description: "The user's name.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"role_ids": {
+				Description: "The IDs of the roles the user has in the tenant.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"superuser": {
+				Description: "Whether the user is a super user.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"verified": {
+				Description: "Whether the user has verified their email address.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+			"metadata": {
+				Description: "The user's metadata. Values that are not strings are JSON-encoded.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// fronteggUserRoleIDs returns the IDs of the roles u holds in tenantID, or in
+// every tenant when tenantID is empty. Responses that do not list the user's
+// tenants carry the roles of the requested tenant directly.
+func fronteggUserRoleIDs(u fronteggUser, tenantID string) []string {
+	seen := map[string]bool{}
+	var out []string
+	add := func(roles []fronteggUserRole) {
+		for _, role := range roles {
+			if !seen[role.Id] {
+				seen[role.Id] = true
+				out = append(out, role.Id)
+			}
+		}
+	}
+	if len(u.Tenants) == 0 {
+		add(u.ReadRoleIDs)
+	}
+	for _, tenant := range u.Tenants {
+		if tenantID == "" || tenant.TenantID == tenantID {
+			add(tenant.Roles)
+		}
+	}
+	sort.Strings(out)
+	return out
+}
+
+func dataSourceFronteggUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	tenantID := d.Get("tenant_id").(string)
+
+	userID := d.Get("user_id").(string)
+	if email := d.Get("email").(string); email != "" {
+		var byEmail fronteggUser
+		if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/email?%s", fronteggUserPathV1, url.Values{"email": {email}}.Encode()), &byEmail); err != nil {
+			if restclient.IsNotFound(err) {
+				return diag.Errorf("unable to find user with email %q", email)
+			}
+			return diag.FromErr(err)
+		}
+		userID = byEmail.Key
+	}
+
+	var out fronteggUser
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggUserPathV1, userID), headers, &out); err != nil {
+		if restclient.IsNotFound(err) {
+			return diag.Errorf("unable to find user %q in tenant %q", userID, tenantID)
+		}
+		return diag.FromErr(err)
+	}
+
+	metadata, err := decodeFronteggMetadata(out.Metadata)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(out.Key)
+	if err := d.Set("user_id", out.Key); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", out.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("name", out.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role_ids", fronteggUserRoleIDs(out, tenantID)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("superuser", out.SuperUser); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verified", out.Verified); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
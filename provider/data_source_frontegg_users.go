@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type fronteggUserListPage struct {
+	Items    []fronteggUser `json:"items"`
+	Metadata struct {
+		TotalItems *int `json:"totalItems"`
+	} `json:"_metadata"`
+}
+
+func dataSourceFronteggUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Lists Frontegg users, optionally filtered by tenant, role and verification status.",
+		ReadContext: dataSourceFronteggUsersRead,
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "Only include members of this tenant. Role IDs are then reported for this tenant only.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"role_id": {
+				Description: "Only include users that have this role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"verified": {
+				Description: "Only include users whose verification status matches. Unset includes both.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+			"ids": {
+				Description: "The IDs of the matching users, sorted by email.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"users": {
+				Description: "The matching users, sorted by email.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+					"id":        {Type: schema.TypeString, Computed: true},
+					"email":     {Type: schema.TypeString, Computed: true},
+					"name":      {Type: schema.TypeString, Computed: true},
+					"tenant_id": {Type: schema.TypeString, Computed: true},
+					"role_ids":  {Type: schema.TypeSet, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+					"superuser": {Type: schema.TypeBool, Computed: true},
+					"verified":  {Type: schema.TypeBool, Computed: true},
+					"metadata":  {Type: schema.TypeMap, Computed: true, Elem: &schema.Schema{Type: schema.TypeString}},
+				}},
+			},
+		},
+	}
+}
+
+// listFronteggUsers pages through every user, restricted to tenantID if set.
+func listFronteggUsers(ctx context.Context, c *restclient.Client, tenantID string) ([]fronteggUser, error) {
+	const limit = 100
+	offset := 0
+	var all []fronteggUser
+	for {
+		q := url.Values{}
+		if tenantID != "" {
+			q.Set("_tenantId", tenantID)
+		}
+		q.Set("_offset", fmt.Sprintf("%d", offset))
+		q.Set("_limit", fmt.Sprintf("%d", limit))
+
+		var page fronteggUserListPage
+		if err := c.Get(ctx, fronteggUserPathV3+"?"+q.Encode(), &page); err != nil {
+			return nil, err
+		}
+		all = append(all, page.Items...)
+		offset += limit
+		// A short page is the last one. totalItems only ends paging early
+		// when the response carries it.
+		if len(page.Items) < limit {
+			break
+		}
+		if total := page.Metadata.TotalItems; total != nil && offset >= *total {
+			break
+		}
+	}
+	return all, nil
+}
+
+// filterFronteggUsers returns the users that have roleID in tenantID (or in
+// any tenant) and, if verified is non-nil, whose verification status matches,
+// sorted by email.
+func filterFronteggUsers(users []fronteggUser, tenantID, roleID string, verified *bool) []fronteggUser {
+	var out []fronteggUser
+	for _, user := range users {
+		if roleID != "" && !stringInSlice(roleID, fronteggUserRoleIDs(user, tenantID)) {
+			continue
+		}
+		if verified != nil && user.Verified != *verified {
+			continue
+		}
+		out = append(out, user)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Email < out[j].Email })
+	return out
+}
+
+func dataSourceFronteggUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	tenantID := d.Get("tenant_id").(string)
+	all, err := listFronteggUsers(ctx, &clientHolder.ApiClient, tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// verified is a tri-state filter: only apply it when it is set in config.
+	var verified *bool
+	if !d.GetRawConfig().GetAttr("verified").IsNull() {
+		v := d.Get("verified").(bool)
+		verified = &v
+	}
+	users := filterFronteggUsers(all, tenantID, d.Get("role_id").(string), verified)
+
+	ids := make([]string, 0, len(users))
+	out := make([]map[string]interface{}, 0, len(users))
+	for _, user := range users {
+		metadata, err := decodeFronteggMetadata(user.Metadata)
+		if err != nil {
+			return diag.Errorf("user %q: %s", user.Key, err)
+		}
+		ids = append(ids, user.Key)
+		out = append(out, map[string]interface{}{
+			"id":        user.Key,
+			"email":     user.Email,
+			"name":      user.Name,
+			"tenant_id": user.TenantID,
+			"role_ids":  fronteggUserRoleIDs(user, tenantID),
+			"superuser": user.SuperUser,
+			"verified":  user.Verified,
+			"metadata":  metadata,
+		})
+	}
+
+	if err := d.Set("ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("users", out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id.UniqueId())
+	return nil
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFronteggUserRoleIDs(t *testing.T) {
+	multiTenant := fronteggUser{Tenants: []fronteggUserTenant{
+		{TenantID: "t1", Roles: []fronteggUserRole{{Id: "admin"}, {Id: "viewer"}}},
+		{TenantID: "t2", Roles: []fronteggUserRole{{Id: "viewer"}, {Id: "billing"}}},
+	}}
+	if got, want := fronteggUserRoleIDs(multiTenant, "t2"), []string{"billing", "viewer"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("roles in t2 = %v, want %v", got, want)
+	}
+	if got, want := fronteggUserRoleIDs(multiTenant, ""), []string{"admin", "billing", "viewer"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("roles in all tenants = %v, want %v", got, want)
+	}
+
+	// Responses for a single tenant list the roles directly.
+	single := fronteggUser{ReadRoleIDs: []fronteggUserRole{{Id: "viewer"}}}
+	if got, want := fronteggUserRoleIDs(single, "t1"), []string{"viewer"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("roles = %v, want %v", got, want)
+	}
+}
+
+func TestFilterFronteggUsers(t *testing.T) {
+	users := []fronteggUser{
+		{Key: "3", Email: "carol@example.com", Verified: false, Tenants: []fronteggUserTenant{{TenantID: "t1", Roles: []fronteggUserRole{{Id: "admin"}}}}},
+		{Key: "1", Email: "alice@example.com", Verified: true, Tenants: []fronteggUserTenant{{TenantID: "t1", Roles: []fronteggUserRole{{Id: "viewer"}}}, {TenantID: "t2", Roles: []fronteggUserRole{{Id: "admin"}}}}},
+		{Key: "2", Email: "bob@example.com", Verified: true, Tenants: []fronteggUserTenant{{TenantID: "t1", Roles: []fronteggUserRole{{Id: "admin"}}}}},
+	}
+	ids := func(users []fronteggUser) []string {
+		var out []string
+		for _, u := range users {
+			out = append(out, u.Key)
+		}
+		return out
+	}
+	yes, no := true, false
+
+	tests := []struct {
+		name     string
+		tenantID string
+		roleID   string
+		verified *bool
+		want     []string
+	}{
+		{"no filters sorts by email", "", "", nil, []string{"1", "2", "3"}},
+		{"role in any tenant", "", "admin", nil, []string{"1", "2", "3"}},
+		{"role in tenant", "t1", "admin", nil, []string{"2", "3"}},
+		{"verified", "", "", &yes, []string{"1", "2"}},
+		{"unverified", "", "", &no, []string{"3"}},
+		{"all filters", "t1", "admin", &yes, []string{"2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(filterFronteggUsers(users, tt.tenantID, tt.roleID, tt.verified))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterFronteggUsers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"sort"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceFronteggWorkspace() *schema.Resource {
+	return &schema.Resource{
+		Description: `Reads the settings of the Frontegg workspace that other stacks need to integrate with it: the Frontegg
+domain, the DNS records for custom domains, hosted login and the SAML service provider details. Use this in stacks
+that do not own ` + "`frontegg_workspace`" + `.`,
+		ReadContext: dataSourceFronteggWorkspaceRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The human-readable name of the workspace.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"frontegg_domain": {
+				Description: "The domain at which the Frontegg API is served for this workspace (the vendor host).",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"allowed_origins": {
+				Description: "The origins that are allowed to access the workspace.",
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_domains": {
+				Description: "The custom domains of the workspace, sorted by domain.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Description: "The custom domain.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The verification status of the domain, e.g. `Pending` or `Active`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"records": {
+							Description: "The DNS records, such as the CNAME, that must exist for the domain to verify.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Description: "The DNS record type.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"name": {
+										Description: "The DNS record name.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"value": {
+										Description: "The DNS record value.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"hosted_login": {
+				Description: "The Frontegg-hosted OAuth login configuration. Empty if hosted login is not active.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_redirect_urls": {
+							Description: "The allowed redirect URLs.",
+							Type:        schema.TypeSet,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"saml": {
+				Description: "The SAML service provider details to configure in identity providers. Empty if SAML is not active.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"acs_url": {
+							Description: "The assertion consumer service URL.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sp_entity_id": {
+							Description: "The service provider entity ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"redirect_url": {
+							Description: "The URL to redirect to after the SAML exchange.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// flattenFronteggCustomDomains converts custom domains into the custom_domains
+// attribute of the workspace data source, sorted by domain.
+func flattenFronteggCustomDomains(domains []fronteggCustomDomain) []interface{} {
+	sorted := append([]fronteggCustomDomain(nil), domains...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].CustomDomain < sorted[j].CustomDomain })
+	out := make([]interface{}, 0, len(sorted))
+	for _, cd := range sorted {
+		records := make([]interface{}, 0, len(cd.Records))
+		for _, r := range cd.Records {
+			records = append(records, map[string]interface{}{
+				"type":  r.Type,
+				"name":  r.Name,
+				"value": r.Value,
+			})
+		}
+		out = append(out, map[string]interface{}{
+			"domain":  cd.CustomDomain,
+			"status":  cd.Status,
+			"records": records,
+		})
+	}
+	return out
+}
+
+func dataSourceFronteggWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+
+	vendor, err := fetchFronteggVendor(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(vendor.ID)
+	if err := d.Set("name", vendor.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("frontegg_domain", vendor.Host); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("allowed_origins", trimRightFromStringSlice(vendor.AllowedOrigins, "/")); err != nil {
+		return diag.FromErr(err)
+	}
+
+	customDomains, err := fetchFronteggCustomDomains(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("custom_domains", flattenFronteggCustomDomains(customDomains.CustomDomains)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	active, allowedRedirectURLs, err := fetchFronteggOAuth(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hostedLogin := []interface{}{}
+	if active {
+		hostedLogin = append(hostedLogin, map[string]interface{}{
+			"allowed_redirect_urls": allowedRedirectURLs,
+		})
+	}
+	if err := d.Set("hosted_login", hostedLogin); err != nil {
+		return diag.FromErr(err)
+	}
+
+	saml, err := fetchFronteggSSOSAML(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	samlItems := []interface{}{}
+	if saml != nil && saml.IsActive {
+		samlItems = append(samlItems, map[string]interface{}{
+			"acs_url":      saml.Configuration.ACSUrl,
+			"sp_entity_id": saml.Configuration.SPEntityID,
+			"redirect_url": saml.Configuration.RedirectUrl,
+		})
+	}
+	if err := d.Set("saml", samlItems); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFlattenFronteggCustomDomains(t *testing.T) {
+	got := flattenFronteggCustomDomains([]fronteggCustomDomain{
+		{CustomDomain: "login.example.com", Status: "Pending", Records: []fronteggCustomDomainRecord{
+			{Type: "CNAME", Name: "login.example.com", Value: "abc.cname.frontegg.com"},
+		}},
+		{CustomDomain: "auth.example.com", Status: "Active"},
+	})
+	want := []interface{}{
+		map[string]interface{}{"domain": "auth.example.com", "status": "Active", "records": []interface{}{}},
+		map[string]interface{}{"domain": "login.example.com", "status": "Pending", "records": []interface{}{
+			map[string]interface{}{"type": "CNAME", "name": "login.example.com", "value": "abc.cname.frontegg.com"},
+		}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("flattenFronteggCustomDomains() = %#v, want %#v", got, want)
+	}
+
+	d := dataSourceFronteggWorkspace().Data(nil)
+	if err := d.Set("custom_domains", got); err != nil {
+		t.Fatalf("custom_domains does not match the schema: %v", err)
+	}
+	if v := d.Get("custom_domains.1.records.0.value"); v != "abc.cname.frontegg.com" {
+		t.Errorf("records not stored, got %v", v)
+	}
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// deletionProtectionSchema is the `deletion_protection` argument shared by
+// resources whose loss would be hard to recover from.
+func deletionProtectionSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `Whether Terraform is prevented from destroying or replacing this resource. While enabled, any plan
+that replaces the resource and any destroy fail. To remove the resource, first set this to ` + "`false`" + ` and apply, then
+destroy it in a separate apply.`,
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+}
+
+// checkDeletionProtection must be called at the start of Delete. It fails while
+// deletion_protection is enabled in state.
+func checkDeletionProtection(d *schema.ResourceData, resourceType string) diag.Diagnostics {
+	if !d.Get("deletion_protection").(bool) {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Deletion protection is enabled",
+		Detail: fmt.Sprintf("%s %q has deletion_protection set to true and cannot be destroyed or replaced. "+
+			"Set deletion_protection = false and apply that change on its own before destroying it.", resourceType, d.Id()),
+	}}
+}
+
+// fronteggChangeGetter is satisfied by both *schema.ResourceData and
+// *schema.ResourceDiff, letting plan-time checks run in tests.
+type fronteggChangeGetter interface {
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// deletionProtectionCustomizeDiff fails the plan when a change to any of the
+// forceNew attributes would replace a protected resource, rather than leaving
+// the failure to the Delete half of the replacement at apply time.
+func deletionProtectionCustomizeDiff(resourceType string, forceNew ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" {
+			return nil
+		}
+		if changed := deletionProtectionReplacingKeys(d, forceNew); len(changed) > 0 {
+			return fmt.Errorf("%s %q has deletion_protection set to true, but changing %s requires replacing it; "+
+				"set deletion_protection = false and apply that change on its own first", resourceType, d.Id(), strings.Join(changed, ", "))
+		}
+		return nil
+	}
+}
+
+// deletionProtectionReplacingKeys returns the forceNew attributes that change
+// while the resource is protected. Enabling protection in the same plan counts
+// as protected.
+func deletionProtectionReplacingKeys(d fronteggChangeGetter, forceNew []string) []string {
+	before, after := d.GetChange("deletion_protection")
+	if !before.(bool) && !after.(bool) {
+		return nil
+	}
+	var changed []string
+	for _, key := range forceNew {
+		if d.HasChange(key) {
+			changed = append(changed, key)
+		}
+	}
+	return changed
+}
+
+// preserveDeletionProtection keeps deletion_protection in state on Read.
+// Frontegg does not store it, so after an import it takes its default.
+func preserveDeletionProtection(d *schema.ResourceData) error {
+	return d.Set("deletion_protection", d.Get("deletion_protection").(bool))
+}
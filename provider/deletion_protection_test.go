@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestDeletionProtectionReplacingKeys(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  map[string]interface{}
+		want []string
+	}{
+		{
+			name: "unprotected",
+			raw:  map[string]interface{}{"name": "Acme", "key": "acme"},
+			want: nil,
+		},
+		{
+			name: "protected key change",
+			raw:  map[string]interface{}{"name": "Acme", "key": "acme", "deletion_protection": true},
+			want: []string{"key"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceFronteggTenant().Schema, tt.raw)
+			if got := deletionProtectionReplacingKeys(d, []string{"key"}); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("deletionProtectionReplacingKeys() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionBlocksDelete(t *testing.T) {
+	deletes := map[string]schema.DeleteContextFunc{
+		"frontegg_tenant":      resourceFronteggTenantDelete,
+		"frontegg_user":        resourceFronteggUserDelete,
+		"frontegg_application": resourceFronteggApplicationDelete,
+		"user source":          resourceFronteggAuth0UserSourceDelete,
+	}
+	schemas := map[string]map[string]*schema.Schema{
+		"frontegg_tenant":      resourceFronteggTenant().Schema,
+		"frontegg_user":        resourceFronteggUser().Schema,
+		"frontegg_application": resourceFronteggApplication().Schema,
+		"user source":          resourceFronteggAuth0UserSource().Schema,
+	}
+	for name, del := range deletes {
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, schemas[name], map[string]interface{}{"deletion_protection": true})
+			d.SetId("protected-id")
+
+			// A nil meta would panic if Delete reached the API client.
+			diags := del(context.Background(), d, nil)
+			if !diags.HasError() {
+				t.Fatal("expected Delete to fail while deletion_protection is enabled")
+			}
+			if diags[0].Summary != "Deletion protection is enabled" ||
+				!strings.Contains(diags[0].Detail, name) || !strings.Contains(diags[0].Detail, "protected-id") {
+				t.Errorf("unexpected diagnostic: %+v", diags[0])
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionSchemas(t *testing.T) {
+	prov := New("0.0.0")()
+	for _, name := range []string{
+		"frontegg_tenant",
+		"frontegg_user",
+		"frontegg_application",
+		"frontegg_auth0_user_source",
+		"frontegg_cognito_user_source",
+		"frontegg_firebase_user_source",
+		"frontegg_custom_code_user_source",
+		"frontegg_federation_user_source",
+	} {
+		if _, ok := prov.ResourcesMap[name].Schema["deletion_protection"]; !ok {
+			t.Errorf("%s: missing deletion_protection", name)
+		}
+	}
+}
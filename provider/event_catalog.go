@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	fronteggWebhookEventsPath     = fronteggWebhookPath + "/events"
+	fronteggWebhookCategoriesPath = fronteggWebhookPath + "/categories"
+	fronteggPrehookEventsPath     = fronteggPrehookPath + "/events"
+)
+
+const (
+	fronteggEventKindWebhook = "webhook"
+	fronteggEventKindPrehook = "prehook"
+)
+
+type fronteggCatalogEvent struct {
+	Key         string `json:"key"`
+	DisplayName string `json:"displayName,omitempty"`
+	Description string `json:"description,omitempty"`
+	CategoryID  string `json:"categoryId,omitempty"`
+}
+
+type fronteggCatalogCategory struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// fronteggEventCatalog lists the events that webhooks and prehooks can
+// subscribe to. Webhook events carry their category name.
+type fronteggEventCatalog struct {
+	Webhook    []fronteggCatalogEvent
+	Prehook    []fronteggCatalogEvent
+	Categories map[string]string
+}
+
+// fetchFronteggEvents lists the events of kind. Webhook events come from the
+// portal API and prehook events from the management API, so one can be
+// unavailable while the other is not.
+func fetchFronteggEvents(ctx context.Context, clientHolder *restclient.ClientHolder, kind string) ([]fronteggCatalogEvent, error) {
+	var events []fronteggCatalogEvent
+	if kind == fronteggEventKindPrehook {
+		if err := clientHolder.ApiClient.Get(ctx, fronteggPrehookEventsPath, &events); err != nil {
+			return nil, fmt.Errorf("listing prehook events: %w", err)
+		}
+	} else {
+		if err := clientHolder.PortalClient.Get(ctx, fronteggWebhookEventsPath, &events); err != nil {
+			return nil, fmt.Errorf("listing webhook events: %w", err)
+		}
+	}
+	sort.Slice(events, func(i, j int) bool { return events[i].Key < events[j].Key })
+	return events, nil
+}
+
+func fetchFronteggEventCatalog(ctx context.Context, clientHolder *restclient.ClientHolder) (*fronteggEventCatalog, error) {
+	catalog := &fronteggEventCatalog{Categories: map[string]string{}}
+	var err error
+	if catalog.Webhook, err = fetchFronteggEvents(ctx, clientHolder, fronteggEventKindWebhook); err != nil {
+		return nil, err
+	}
+	var categories []fronteggCatalogCategory
+	if err := clientHolder.PortalClient.Get(ctx, fronteggWebhookCategoriesPath, &categories); err != nil {
+		return nil, fmt.Errorf("listing webhook event categories: %w", err)
+	}
+	for _, c := range categories {
+		catalog.Categories[c.ID] = c.Name
+	}
+	if catalog.Prehook, err = fetchFronteggEvents(ctx, clientHolder, fronteggEventKindPrehook); err != nil {
+		return nil, err
+	}
+	return catalog, nil
+}
+
+// fronteggEventsCacheKey keys the events of one kind in ClientHolder.Cache.
+type fronteggEventsCacheKey string
+
+// fronteggEventsCacheEntry is fetched once; a failed fetch is kept too, so
+// that apply can report the validation skipped while planning.
+type fronteggEventsCacheEntry struct {
+	sync.Mutex
+	fetched bool
+	events  []fronteggCatalogEvent
+	err     error
+}
+
+// cachedFronteggEvents returns the events of kind, fetching them once per
+// configured provider so that planning many webhooks and prehooks fetches
+// them once.
+func cachedFronteggEvents(ctx context.Context, clientHolder *restclient.ClientHolder, kind string) ([]fronteggCatalogEvent, error) {
+	value, _ := clientHolder.Cache.LoadOrStore(fronteggEventsCacheKey(kind), &fronteggEventsCacheEntry{})
+	entry := value.(*fronteggEventsCacheEntry)
+	entry.Lock()
+	defer entry.Unlock()
+	if !entry.fetched {
+		entry.events, entry.err = fetchFronteggEvents(ctx, clientHolder, kind)
+		entry.fetched = true
+	}
+	return entry.events, entry.err
+}
+
+// eventCatalogCustomizeDiff fails the plan when events lists a key that is not
+// in the event catalog for kind. The check is skipped if the catalog cannot be
+// fetched, leaving the API to reject unknown events at apply time; apply then
+// reports the skip through eventCatalogSkippedWarning.
+func eventCatalogCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}, kind string) error {
+	clientHolder, ok := meta.(*restclient.ClientHolder)
+	if !ok || !d.HasChange("events") || !d.NewValueKnown("events") {
+		return nil
+	}
+	events, err := cachedFronteggEvents(ctx, clientHolder, kind)
+	if err != nil {
+		log.Printf("[WARN] Skipping %s event validation: %s", kind, err)
+		return nil
+	}
+	keys := make([]string, 0, len(events))
+	for _, e := range events {
+		keys = append(keys, e.Key)
+	}
+	return checkFronteggEvents(kind, stringSetToList(d.Get("events").(*schema.Set)), keys)
+}
+
+// eventCatalogSkippedWarning returns a warning if the event catalog for kind
+// could not be fetched, meaning events were not validated while planning.
+// CustomizeDiff cannot return warnings, so Create and Update surface it.
+func eventCatalogSkippedWarning(clientHolder *restclient.ClientHolder, kind string) diag.Diagnostics {
+	value, ok := clientHolder.Cache.Load(fronteggEventsCacheKey(kind))
+	if !ok {
+		return nil
+	}
+	entry := value.(*fronteggEventsCacheEntry)
+	entry.Lock()
+	defer entry.Unlock()
+	if entry.err == nil {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Skipped %s event validation", kind),
+		Detail:   fmt.Sprintf("The %s event catalog could not be fetched, so events were not checked against it: %s", kind, entry.err),
+	}}
+}
+
+// checkFronteggEvents reports every configured event that is not in valid,
+// with the nearest valid key as a suggestion.
+func checkFronteggEvents(kind string, configured, valid []string) error {
+	if len(valid) == 0 {
+		return nil
+	}
+	var problems []string
+	for _, event := range configured {
+		if stringInSlice(event, valid) {
+			continue
+		}
+		problem := fmt.Sprintf("%q is not a %s event", event, kind)
+		if suggestion := nearestFronteggEvent(event, valid); suggestion != "" {
+			problem += fmt.Sprintf("; did you mean %q?", suggestion)
+		}
+		problems = append(problems, problem)
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s (see the frontegg_event_catalog data source for the available events)", strings.Join(problems, ", "))
+}
+
+// nearestFronteggEvent returns the key in valid closest to event by edit
+// distance, ignoring case, or "" if none is plausibly what was meant.
+func nearestFronteggEvent(event string, valid []string) string {
+	best, bestDistance := "", -1
+	for _, key := range valid {
+		distance := levenshtein(strings.ToLower(event), strings.ToLower(key))
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = key, distance
+		}
+	}
+	if bestDistance < 0 || bestDistance > max(3, len(event)/3) {
+		return ""
+	}
+	return best
+}
+
+func levenshtein(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	prev := make([]int, len(br)+1)
+	curr := make([]int, len(br)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		curr[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(br)]
+}
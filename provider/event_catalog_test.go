@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestCheckFronteggEvents(t *testing.T) {
+	valid := []string{"frontegg.user.authenticated", "frontegg.user.created", "frontegg.tenant.created"}
+
+	if err := checkFronteggEvents(fronteggEventKindWebhook, []string{"frontegg.user.created"}, valid); err != nil {
+		t.Errorf("unexpected error for a valid event: %v", err)
+	}
+
+	err := checkFronteggEvents(fronteggEventKindWebhook, []string{"frontegg.user.authenticatd", "frontegg.user.created"}, valid)
+	if err == nil {
+		t.Fatal("expected an error for a misspelled event")
+	}
+	if !strings.Contains(err.Error(), `did you mean "frontegg.user.authenticated"?`) {
+		t.Errorf("expected a suggestion, got %v", err)
+	}
+
+	err = checkFronteggEvents(fronteggEventKindPrehook, []string{"something.else.entirely"}, valid)
+	if err == nil || strings.Contains(err.Error(), "did you mean") {
+		t.Errorf("expected an error without a suggestion, got %v", err)
+	}
+
+	// An empty catalog disables the check rather than rejecting everything.
+	if err := checkFronteggEvents(fronteggEventKindPrehook, []string{"SIGN_UP"}, nil); err != nil {
+		t.Errorf("unexpected error with an empty catalog: %v", err)
+	}
+}
+
+func TestNearestFronteggEventIgnoresCase(t *testing.T) {
+	if got := nearestFronteggEvent("sign_up", []string{"SIGN_UP", "USER_INVITE"}); got != "SIGN_UP" {
+		t.Errorf("nearestFronteggEvent() = %q, want SIGN_UP", got)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	for _, tt := range []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"kitten", "sitting", 3},
+		{"SIGN_UP", "SIGN_UP", 0},
+	} {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCachedFronteggEventsFetchesKindsIndependently(t *testing.T) {
+	prehookRequests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case fronteggPrehookEventsPath:
+			prehookRequests++
+			_ = json.NewEncoder(w).Encode([]fronteggCatalogEvent{{Key: "SIGN_UP"}})
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{
+		ApiClient:    restclient.MakeRestClient(srv.URL, "", ""),
+		PortalClient: restclient.MakeRestClient(srv.URL, "", ""),
+	}
+	holder.ApiClient.Authenticate("test-token")
+	holder.PortalClient.Authenticate("test-token")
+
+	for i := 0; i < 2; i++ {
+		events, err := cachedFronteggEvents(context.Background(), holder, fronteggEventKindPrehook)
+		if err != nil || len(events) != 1 || events[0].Key != "SIGN_UP" {
+			t.Fatalf("prehook events: got %v, %v", events, err)
+		}
+	}
+	if prehookRequests != 1 {
+		t.Errorf("expected the prehook events to be fetched once, got %d requests", prehookRequests)
+	}
+	if diags := eventCatalogSkippedWarning(holder, fronteggEventKindPrehook); len(diags) != 0 {
+		t.Errorf("expected no warning for prehooks, got %v", diags)
+	}
+
+	if _, err := cachedFronteggEvents(context.Background(), holder, fronteggEventKindWebhook); err == nil {
+		t.Fatal("expected the webhook events to fail")
+	}
+	diags := eventCatalogSkippedWarning(holder, fronteggEventKindWebhook)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning for webhooks, got %v", diags)
+	}
+}
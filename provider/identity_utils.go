@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// requiredIdentityAttribute returns a string identity attribute that must be
+// supplied when importing with an `identity` block.
+func requiredIdentityAttribute(description string) *schema.Schema {
+	return &schema.Schema{
+		Description:       description,
+		Type:              schema.TypeString,
+		RequiredForImport: true,
+	}
+}
+
+// importIdentity resolves the attributes that make up a composite import ID.
+//
+// A string ID (`terraform import` or an import block with `id`) is split on sep
+// into keys, in order. An import block with `identity = {...}` leaves the ID
+// empty, so the values are read from the resource identity instead. Either way
+// every attribute must be non-empty.
+func importIdentity(d *schema.ResourceData, sep string, keys ...string) (map[string]string, error) {
+	values := make(map[string]string, len(keys))
+	if raw := d.Id(); raw != "" {
+		parts := strings.SplitN(raw, sep, len(keys))
+		if len(parts) != len(keys) {
+			return nil, fmt.Errorf("invalid import ID format, expected %s, got: %s", strings.Join(keys, sep), raw)
+		}
+		for i, key := range keys {
+			values[key] = parts[i]
+		}
+	} else {
+		identity, err := d.Identity()
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			values[key], _ = identity.Get(key).(string)
+		}
+	}
+
+	for _, key := range keys {
+		if values[key] == "" {
+			return nil, fmt.Errorf("invalid import: %s must not be empty (expected %s)", key, strings.Join(keys, sep))
+		}
+	}
+	return values, nil
+}
+
+// setIdentity records the resource identity in state. It must be called
+// whenever Create, Read or Update succeeds, since Terraform rejects an empty
+// identity for resources that declare an identity schema.
+func setIdentity(d *schema.ResourceData, values map[string]interface{}) error {
+	identity, err := d.Identity()
+	if err != nil {
+		return err
+	}
+	for key, value := range values {
+		if err := identity.Set(key, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
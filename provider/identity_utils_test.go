@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func apiTokenIdentityResourceData(t *testing.T, identity map[string]string) *schema.ResourceData {
+	t.Helper()
+	r := resourceFronteggTenantAPIToken()
+	return schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), identity)
+}
+
+func TestImportIdentityFromStringID(t *testing.T) {
+	for _, tc := range []struct {
+		id      string
+		wantErr bool
+	}{
+		{id: "tenant-1:client-1"},
+		{id: "tenant-1", wantErr: true},
+		{id: ":client-1", wantErr: true},
+		{id: "tenant-1:", wantErr: true},
+	} {
+		d := apiTokenIdentityResourceData(t, nil)
+		d.SetId(tc.id)
+		got, err := importIdentity(d, ":", "tenant_id", "client_id")
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("import %q: expected error", tc.id)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("import %q: %v", tc.id, err)
+			continue
+		}
+		if got["tenant_id"] != "tenant-1" || got["client_id"] != "client-1" {
+			t.Errorf("import %q: got %v", tc.id, got)
+		}
+	}
+}
+
+func TestImportIdentityFromIdentity(t *testing.T) {
+	d := apiTokenIdentityResourceData(t, map[string]string{
+		"tenant_id": "tenant-1",
+		"client_id": "client-1",
+	})
+	got, err := resourceFronteggTenantAPITokenImport(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if got[0].Id() != "client-1" || got[0].Get("tenant_id").(string) != "tenant-1" {
+		t.Errorf("import: id=%q tenant_id=%q", got[0].Id(), got[0].Get("tenant_id"))
+	}
+}
+
+func TestImportIdentityRejectsEmptyIdentity(t *testing.T) {
+	d := apiTokenIdentityResourceData(t, map[string]string{
+		"tenant_id": "tenant-1",
+	})
+	if _, err := importIdentity(d, ":", "tenant_id", "client_id"); err == nil {
+		t.Error("expected an error for an identity without client_id")
+	}
+}
+
+func TestSetIdentity(t *testing.T) {
+	d := apiTokenIdentityResourceData(t, nil)
+	if err := setIdentity(d, map[string]interface{}{
+		"tenant_id": "tenant-1",
+		"client_id": "client-1",
+	}); err != nil {
+		t.Fatalf("setIdentity: %v", err)
+	}
+	identity, err := d.Identity()
+	if err != nil {
+		t.Fatalf("Identity: %v", err)
+	}
+	if identity.Get("tenant_id").(string) != "tenant-1" || identity.Get("client_id").(string) != "client-1" {
+		t.Errorf("identity not recorded: tenant_id=%q client_id=%q", identity.Get("tenant_id"), identity.Get("client_id"))
+	}
+}
+
+func TestResourceIdentitySchemas(t *testing.T) {
+	prov := New("0.0.0")()
+	if err := prov.InternalValidate(); err != nil {
+		t.Fatalf("provider failed to validate: %v", err)
+	}
+	for _, name := range []string{
+		"frontegg_tenant_api_token",
+		"frontegg_tenant_saml_config",
+		"frontegg_tenant_oidc_config",
+		"frontegg_tenant_sso_domain",
+		"frontegg_tenant_sso_group_mapping",
+		"frontegg_tenant_sso_domain_validation",
+		"frontegg_associated_domain",
+		"frontegg_application_tenant_assignment",
+		"frontegg_entitlement",
+	} {
+		if prov.ResourcesMap[name].Identity == nil {
+			t.Errorf("%s: missing resource identity", name)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// Singleton configuration resources cannot be deleted. on_destroy chooses what
+// happens to the settings when such a resource is destroyed.
+const (
+	onDestroyAbandon = "abandon"
+	onDestroyReset   = "reset"
+)
+
+// onDestroySnapshotID is the ID of the scratch copy that Read fills in when
+// the snapshot is captured.
+const onDestroySnapshotID = "snapshot"
+
+func onDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: `What to do with the settings when this resource is destroyed. ` + "`abandon`" + ` (the default) only removes
+the resource from the Terraform state, leaving the last-applied settings in place. ` + "`reset`" + ` writes back the settings
+that were in place before Terraform first applied this resource, as recorded in ` + "`on_destroy_snapshot`" + `.`,
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      onDestroyAbandon,
+		ValidateFunc: validation.StringInSlice([]string{onDestroyAbandon, onDestroyReset}, false),
+	}
+}
+
+func onDestroySnapshotSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "The settings captured when this resource was created or imported, restored on destroy when `on_destroy` is `reset`.",
+		Type:        schema.TypeString,
+		Computed:    true,
+		Sensitive:   true,
+	}
+}
+
+// onDestroyImporter captures the snapshot at import time, when the settings
+// have not yet been changed by Terraform.
+func onDestroyImporter(resource func() *schema.Resource) *schema.ResourceImporter {
+	return &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+			if diags := captureOnDestroySnapshot(ctx, resource(), d, meta); diags.HasError() {
+				return nil, fmt.Errorf("capturing on_destroy snapshot: %s", diags[0].Summary)
+			}
+			return []*schema.ResourceData{d}, nil
+		},
+	}
+}
+
+// captureOnDestroySnapshot runs r's Read against a scratch copy of the
+// resource and stores the resulting attributes in on_destroy_snapshot. Create
+// must call it before writing anything.
+func captureOnDestroySnapshot(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	scratch := r.Data(nil)
+	scratch.SetId(onDestroySnapshotID)
+	if diags := r.ReadContext(ctx, scratch, meta); diags.HasError() {
+		return diags
+	}
+	state := scratch.State()
+	if state == nil {
+		return nil
+	}
+	attributes := make(map[string]string, len(state.Attributes))
+	for key, value := range state.Attributes {
+		attributes[key] = value
+	}
+	delete(attributes, "id")
+	delete(attributes, "on_destroy")
+	delete(attributes, "on_destroy_snapshot")
+
+	snapshot, err := json.Marshal(attributes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("on_destroy_snapshot", string(snapshot)); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// isOnDestroySnapshot reports whether Read is capturing the on_destroy
+// snapshot. There is no state then, so attributes that Read narrows down to
+// what the state manages must record every remote value instead.
+func isOnDestroySnapshot(d *schema.ResourceData) bool {
+	return d.Id() == onDestroySnapshotID
+}
+
+// resetFromOnDestroySnapshot applies the settings recorded in
+// on_destroy_snapshot through r's Update, as if the configuration had been
+// changed back to them.
+func resetFromOnDestroySnapshot(ctx context.Context, r *schema.Resource, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	encoded := d.Get("on_destroy_snapshot").(string)
+	if encoded == "" {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "No settings snapshot to reset to",
+			Detail:   "on_destroy is reset, but no snapshot was captured for this resource. The settings remain in their last-applied state.",
+		}}
+	}
+	var attributes map[string]string
+	if err := json.Unmarshal([]byte(encoded), &attributes); err != nil {
+		return diag.Errorf("decoding on_destroy_snapshot: %s", err)
+	}
+
+	// Plan the snapshot as if it were the configuration, so that Update sees
+	// every setting that differs from the current state as a change.
+	snapshot := r.Data(&terraform.InstanceState{ID: d.Id(), Attributes: attributes})
+	raw := make(map[string]interface{}, len(r.Schema))
+	for key, s := range r.Schema {
+		if key == "on_destroy" || key == "on_destroy_snapshot" || (!s.Optional && !s.Required) {
+			continue
+		}
+		raw[key] = onDestroyConfigValue(snapshot.Get(key))
+	}
+	state := d.State()
+	sm := schema.InternalMap(r.Schema)
+	diff, err := sm.Diff(ctx, state, terraform.NewResourceConfigRaw(raw), nil, meta, false)
+	if err != nil {
+		return diag.Errorf("planning reset from on_destroy_snapshot: %s", err)
+	}
+	restore, err := sm.Data(state, diff)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	log.Printf("[INFO] Resetting %s to the on_destroy_snapshot captured before it was first applied", d.Id())
+	return r.UpdateContext(ctx, restore, meta)
+}
+
+// onDestroyConfigValue converts a value read from ResourceData into the form
+// Terraform configuration takes, replacing sets with lists.
+func onDestroyConfigValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *schema.Set:
+		return onDestroyConfigValue(v.List())
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = onDestroyConfigValue(item)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			out[key] = onDestroyConfigValue(item)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// preserveOnDestroy keeps on_destroy in state on Read. Frontegg does not store
+// it, so after an import it takes its default.
+func preserveOnDestroy(d *schema.ResourceData) error {
+	return d.Set("on_destroy", d.Get("on_destroy").(string))
+}
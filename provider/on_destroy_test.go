@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fakeSingleton is a stand-in for a singleton configuration resource whose
+// remote settings live in remote.
+type fakeSingleton struct {
+	remote map[string]interface{}
+}
+
+func (f *fakeSingleton) resource() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			for key, value := range f.remote {
+				if err := d.Set(key, value); err != nil {
+					return diag.FromErr(err)
+				}
+			}
+			return nil
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			if d.HasChange("name") {
+				f.remote["name"] = d.Get("name")
+			}
+			if d.HasChange("origins") {
+				f.remote["origins"] = d.Get("origins")
+			}
+			return nil
+		},
+		Schema: map[string]*schema.Schema{
+			"name":                {Type: schema.TypeString, Optional: true},
+			"origins":             {Type: schema.TypeList, Optional: true, Elem: &schema.Schema{Type: schema.TypeString}},
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
+		},
+	}
+}
+
+func TestOnDestroySnapshotRoundTrip(t *testing.T) {
+	f := &fakeSingleton{remote: map[string]interface{}{
+		"name":    "original",
+		"origins": []interface{}{"https://a.example.com", "https://b.example.com"},
+	}}
+	r := f.resource()
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":       "managed",
+		"origins":    []interface{}{"https://managed.example.com"},
+		"on_destroy": onDestroyReset,
+	})
+	d.SetId("singleton")
+	if diags := captureOnDestroySnapshot(context.Background(), r, d, nil); diags.HasError() {
+		t.Fatalf("capture: %v", diags)
+	}
+	if d.Get("on_destroy_snapshot").(string) == "" {
+		t.Fatal("expected a snapshot to be recorded")
+	}
+
+	// Simulate the apply writing the managed settings.
+	f.remote["name"] = "managed"
+	f.remote["origins"] = []interface{}{"https://managed.example.com"}
+
+	if diags := resetFromOnDestroySnapshot(context.Background(), r, d, nil); diags.HasError() {
+		t.Fatalf("reset: %v", diags)
+	}
+	want := map[string]interface{}{
+		"name":    "original",
+		"origins": []interface{}{"https://a.example.com", "https://b.example.com"},
+	}
+	if !reflect.DeepEqual(f.remote, want) {
+		t.Errorf("remote after reset = %#v, want %#v", f.remote, want)
+	}
+}
+
+func TestOnDestroyResetWithoutSnapshotWarns(t *testing.T) {
+	f := &fakeSingleton{remote: map[string]interface{}{"name": "managed"}}
+	r := f.resource()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"on_destroy": onDestroyReset})
+	d.SetId("singleton")
+
+	diags := resetFromOnDestroySnapshot(context.Background(), r, d, nil)
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if f.remote["name"] != "managed" {
+		t.Errorf("settings changed without a snapshot: %v", f.remote)
+	}
+}
+
+func TestOnDestroySchemas(t *testing.T) {
+	prov := New("0.0.0")()
+	for _, name := range []string{
+		"frontegg_auth_policy",
+		"frontegg_session_management_policy",
+		"frontegg_admin_portal",
+		"frontegg_sso_domain_policy",
+		"frontegg_workspace",
+		"frontegg_security_rules",
+	} {
+		res := prov.ResourcesMap[name]
+		if _, ok := res.Schema["on_destroy"]; !ok {
+			t.Errorf("%s: missing on_destroy", name)
+		}
+		if _, ok := res.Schema["on_destroy_snapshot"]; !ok {
+			t.Errorf("%s: missing on_destroy_snapshot", name)
+		}
+	}
+}
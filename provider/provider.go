@@ -57,11 +57,27 @@ func New(version string) func() *schema.Provider {
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
-				"frontegg_entitlements": dataSourceFronteggEntitlements(),
-				"frontegg_permission":   dataSourceFronteggPermission(),
-				"frontegg_plan":         dataSourceFronteggPlan(),
+				"frontegg_application":            dataSourceFronteggApplication(),
+				"frontegg_applications":           dataSourceFronteggApplications(),
+				"frontegg_entitlements":           dataSourceFronteggEntitlements(),
+				"frontegg_event_catalog":          dataSourceFronteggEventCatalog(),
+				"frontegg_feature":                dataSourceFronteggFeature(),
+				"frontegg_features":               dataSourceFronteggFeatures(),
+				"frontegg_permission":             dataSourceFronteggPermission(),
+				"frontegg_permissions":            dataSourceFronteggPermissions(),
+				"frontegg_plan":                   dataSourceFronteggPlan(),
+				"frontegg_role":                   dataSourceFronteggRole(),
+				"frontegg_roles":                  dataSourceFronteggRoles(),
+				"frontegg_signing_keys":           dataSourceFronteggSigningKeys(),
+				"frontegg_tenant":                 dataSourceFronteggTenant(),
+				"frontegg_tenant_hierarchy":       dataSourceFronteggTenantHierarchy(),
+				"frontegg_tenant_sso_sp_metadata": dataSourceFronteggTenantSSOSPMetadata(),
+				"frontegg_tenants":                dataSourceFronteggTenants(),
+				"frontegg_user":                   dataSourceFronteggUser(),
+				"frontegg_users":                  dataSourceFronteggUsers(),
+				"frontegg_workspace":              dataSourceFronteggWorkspace(),
 			},
-			ResourcesMap: map[string]*schema.Resource{
+			ResourcesMap: withDefaultTimeouts(map[string]*schema.Resource{
 				"frontegg_permission":                    resourceFronteggPermission(),
 				"frontegg_permission_category":           resourceFronteggPermissionCategory(),
 				"frontegg_role":                          resourceFronteggRole(),
@@ -71,6 +87,7 @@ func New(version string) func() *schema.Provider {
 				"frontegg_social_login":                  resourceFronteggSocialLogin(),
 				"frontegg_auth_policy":                   resourceFronteggAuthPolicy(),
 				"frontegg_email_template":                resourceFronteggEmailTemplate(),
+				"frontegg_sms_template":                  resourceFronteggSMSTemplate(),
 				"frontegg_admin_portal":                  resourceFronteggAdminPortal(),
 				"frontegg_tenant":                        resourceFronteggTenant(),
 				"frontegg_user":                          resourceFronteggUser(),
@@ -78,7 +95,10 @@ func New(version string) func() *schema.Provider {
 				"frontegg_redirect_uri":                  resourceFronteggRedirectUri(),
 				"frontegg_associated_domain":             resourceFronteggAssociatedDomain(),
 				"frontegg_allowed_origin":                resourceFronteggAllowedOrigin(),
+				"frontegg_custom_domain":                 resourceFronteggCustomDomain(),
+				"frontegg_custom_domain_verification":    resourceFronteggCustomDomainVerification(),
 				"frontegg_email_provider":                resourceFronteggEmailProvider(),
+				"frontegg_sms_provider":                  resourceFronteggSMSProvider(),
 				"frontegg_entitlement":                   resourceFronteggEntitlement(),
 				"frontegg_application":                   resourceFronteggApplication(),
 				"frontegg_application_tenant_assignment": resourceFronteggApplicationTenantAssignment(),
@@ -93,16 +113,24 @@ func New(version string) func() *schema.Provider {
 				"frontegg_prehook":                       resourceFronteggPrehook(),
 				"frontegg_secret":                        resourceFronteggSecret(),
 				"frontegg_session_management_policy":     resourceFronteggSessionManagementPolicy(),
+				"frontegg_security_rules":                resourceFronteggSecurityRules(),
 				"frontegg_tenant_saml_config":            resourceFronteggTenantSAMLConfig(),
 				"frontegg_tenant_oidc_config":            resourceFronteggTenantOIDCConfig(),
 				"frontegg_tenant_sso_domain":             resourceFronteggTenantSSODomain(),
 				"frontegg_tenant_sso_group_mapping":      resourceFronteggTenantSSOGroupMapping(),
+				"frontegg_tenant_scim_configuration":     resourceFronteggTenantSCIMConfiguration(),
+				"frontegg_tenant_scim_group_mapping":     resourceFronteggTenantSCIMGroupMapping(),
 				"frontegg_tenant_mfa_policy":             resourceFronteggTenantMFAPolicy(),
+				"frontegg_tenant_ip_restrictions":        resourceFronteggTenantIPRestrictions(),
+				"frontegg_tenant_domain_restrictions":    resourceFronteggTenantDomainRestrictions(),
 				"frontegg_tenant_sso_domain_validation":  resourceFronteggTenantSSODomainValidation(),
 				"frontegg_tenant_api_token":              resourceFronteggTenantAPIToken(),
 				"frontegg_jwt_template":                  resourceFronteggJWTTemplate(),
 				"frontegg_jwt_template_targeting":        resourceFronteggJWTTemplateTargeting(),
-			},
+				"frontegg_group":                         resourceFronteggGroup(),
+				"frontegg_group_membership":              resourceFronteggGroupMembership(),
+				"frontegg_user_tenant_membership":        resourceFronteggUserTenantMembership(),
+			}),
 			ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 				environmentId := d.Get("environment_id").(string)
 				applicationId := d.Get("application_id").(string)
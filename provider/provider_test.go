@@ -3,6 +3,7 @@ package provider
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -41,3 +42,40 @@ func TestValidateResourceSchemas(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateDataSourceSchemas(t *testing.T) {
+	prov := New("0.0.0")()
+	for name, res := range prov.DataSourcesMap {
+		test := res
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			if err := test.InternalValidate(nil, false); err != nil {
+				t.Errorf("Data source failed to validate: %v", err)
+			}
+		})
+	}
+}
+
+func TestResourceTimeouts(t *testing.T) {
+	prov := New("0.0.0")()
+	for name, res := range prov.ResourcesMap {
+		if res.Timeouts == nil {
+			t.Errorf("%s: no timeouts declared", name)
+			continue
+		}
+		if res.Timeouts.Create == nil || res.Timeouts.Read == nil || res.Timeouts.Delete == nil {
+			t.Errorf("%s: create, read and delete timeouts must all be declared", name)
+		}
+		if (res.Timeouts.Update != nil) != (res.UpdateContext != nil) {
+			t.Errorf("%s: update timeout declared = %t, but resource supports update = %t",
+				name, res.Timeouts.Update != nil, res.UpdateContext != nil)
+		}
+	}
+
+	if got := *prov.ResourcesMap["frontegg_prehook"].Timeouts.Create; got != 5*time.Minute {
+		t.Errorf("frontegg_prehook create timeout = %s, want 5m", got)
+	}
+	if got := *prov.ResourcesMap["frontegg_role"].Timeouts.Create; got != fronteggDefaultTimeout {
+		t.Errorf("frontegg_role create timeout = %s, want %s", got, fronteggDefaultTimeout)
+	}
+}
@@ -217,17 +217,17 @@ func resourceFronteggAdminPortal() *schema.Resource {
 
 This resource configures the Frontegg Admin Portal settings, including navigation visibility and theme customization.
 
-**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the admin portal configuration will remain in its last-applied state.`,
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the admin portal configuration will remain in its last-applied state, unless ` + "`on_destroy = \"reset\"`" + ` is set.`,
 
 		CreateContext: resourceFronteggAdminPortalCreate,
 		ReadContext:   resourceFronteggAdminPortalRead,
 		UpdateContext: resourceFronteggAdminPortalUpdate,
 		DeleteContext: resourceFronteggAdminPortalDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      onDestroyImporter(resourceFronteggAdminPortal),
 
 		Schema: map[string]*schema.Schema{
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
 			"enable_account_settings": {
 				Description: "Enable access to account settings in the admin portal.",
 				Type:        schema.TypeBool,
@@ -353,11 +353,17 @@ This resource configures the Frontegg Admin Portal settings, including navigatio
 }
 
 func resourceFronteggAdminPortalCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := captureOnDestroySnapshot(ctx, resourceFronteggAdminPortal(), d, meta); diags.HasError() {
+		return diags
+	}
 	d.SetId("admin_portal")
 	return resourceFronteggAdminPortalUpdate(ctx, d, meta)
 }
 
 func resourceFronteggAdminPortalRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := preserveOnDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 
 	var metadataResponse map[string]interface{}
@@ -710,6 +716,9 @@ func resourceFronteggAdminPortalUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceFronteggAdminPortalDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("on_destroy").(string) == onDestroyReset {
+		return resetFromOnDestroySnapshot(ctx, resourceFronteggAdminPortal(), d, meta)
+	}
 	log.Printf("[WARN] Cannot destroy admin portal configuration. Terraform will remove this resource from the " +
 		"state file, but the admin portal configuration will remain in its last-applied state.")
 	return nil
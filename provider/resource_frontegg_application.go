@@ -164,6 +164,7 @@ func resourceFronteggApplication() *schema.Resource {
 				Computed:    true,
 				Sensitive:   true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -237,6 +238,9 @@ func resourceFronteggApplicationDeserialize(d *schema.ResourceData, f fronteggAp
 	if err := d.Set("metadata", f.Metadata); err != nil {
 		return err
 	}
+	if err := preserveDeletionProtection(d); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -311,6 +315,9 @@ func resourceFronteggApplicationUpdate(ctx context.Context, d *schema.ResourceDa
 }
 
 func resourceFronteggApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(d, "frontegg_application"); diags.HasError() {
+		return diags
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 
 	if err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggApplicationPath, d.Id()), nil); err != nil {
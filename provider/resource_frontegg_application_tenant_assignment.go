@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -32,6 +31,14 @@ func resourceFronteggApplicationTenantAssignment() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceFronteggApplicationTenantAssignmentImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"app_id":    requiredIdentityAttribute("The ID of the application."),
+					"tenant_id": requiredIdentityAttribute("The ID of the tenant."),
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -51,13 +58,13 @@ func resourceFronteggApplicationTenantAssignment() *schema.Resource {
 }
 
 func resourceFronteggApplicationTenantAssignmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.Split(d.Id(), ":")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("invalid import format, expected app_id:tenant_id, got: %s", d.Id())
+	ids, err := importIdentity(d, ":", "app_id", "tenant_id")
+	if err != nil {
+		return nil, err
 	}
 
-	appID := parts[0]
-	tenantID := parts[1]
+	appID := ids["app_id"]
+	tenantID := ids["tenant_id"]
 
 	if err := d.Set("app_id", appID); err != nil {
 		return nil, err
@@ -66,10 +73,21 @@ func resourceFronteggApplicationTenantAssignmentImport(ctx context.Context, d *s
 		return nil, err
 	}
 
-	// Return the resource with the ID set
+	// Identity imports arrive without an ID, so always set the canonical one.
+	d.SetId(fmt.Sprintf("%s:%s", appID, tenantID))
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceFronteggApplicationTenantAssignmentSetIdentity(d *schema.ResourceData) diag.Diagnostics {
+	if err := setIdentity(d, map[string]interface{}{
+		"app_id":    d.Get("app_id").(string),
+		"tenant_id": d.Get("tenant_id").(string),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
 func resourceFronteggApplicationTenantAssignmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
 	appID := d.Get("app_id").(string)
@@ -79,7 +97,7 @@ func resourceFronteggApplicationTenantAssignmentCreate(ctx context.Context, d *s
 	diags := resourceFronteggApplicationTenantAssignmentRead(ctx, d, meta)
 	if len(diags) == 0 && d.Id() != "" {
 		// Assignment already exists, just return
-		return nil
+		return resourceFronteggApplicationTenantAssignmentSetIdentity(d)
 	}
 
 	// If we get here, the assignment doesn't exist, so create it
@@ -95,7 +113,7 @@ func resourceFronteggApplicationTenantAssignmentCreate(ctx context.Context, d *s
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", appID, tenantID))
-	return nil
+	return resourceFronteggApplicationTenantAssignmentSetIdentity(d)
 }
 
 func resourceFronteggApplicationTenantAssignmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -115,8 +133,9 @@ func resourceFronteggApplicationTenantAssignmentRead(ctx context.Context, d *sch
 		}
 		if !found {
 			d.SetId("")
+			return nil
 		}
-		return nil
+		return resourceFronteggApplicationTenantAssignmentSetIdentity(d)
 	}
 
 	return diag.FromErr(err)
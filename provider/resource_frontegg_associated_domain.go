@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,6 +45,14 @@ callbacks, magic links, password resets and passkeys.`,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceFronteggAssociatedDomainImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"platform":         requiredIdentityAttribute("The mobile platform: `ios` or `android`."),
+					"configuration_id": requiredIdentityAttribute("The ID of the associated domain configuration."),
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"platform": {
@@ -152,7 +159,7 @@ func resourceFronteggAssociatedDomainCreate(ctx context.Context, d *schema.Resou
 
 	if id := out.configurationId(); id != "" {
 		d.SetId(id)
-		return nil
+		return resourceFronteggAssociatedDomainSetIdentity(d)
 	}
 
 	// The create response did not carry an id; find the configuration in the list.
@@ -163,7 +170,7 @@ func resourceFronteggAssociatedDomainCreate(ctx context.Context, d *schema.Resou
 	for _, c := range configs {
 		if resourceFronteggAssociatedDomainMatches(d, c) {
 			d.SetId(c.configurationId())
-			return nil
+			return resourceFronteggAssociatedDomainSetIdentity(d)
 		}
 	}
 	return diag.Errorf("associated domain configuration was created but could not be found afterwards")
@@ -197,7 +204,7 @@ func resourceFronteggAssociatedDomainRead(ctx context.Context, d *schema.Resourc
 				return diag.FromErr(err)
 			}
 		}
-		return nil
+		return resourceFronteggAssociatedDomainSetIdentity(d)
 	}
 
 	d.SetId("")
@@ -214,16 +221,31 @@ func resourceFronteggAssociatedDomainDelete(ctx context.Context, d *schema.Resou
 	return nil
 }
 
-// Import expects "{platform}/{configurationId}", because the API namespaces
-// configurations by platform and the id alone does not say which list to read.
+func resourceFronteggAssociatedDomainSetIdentity(d *schema.ResourceData) diag.Diagnostics {
+	if err := setIdentity(d, map[string]interface{}{
+		"platform":         d.Get("platform").(string),
+		"configuration_id": d.Id(),
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// Import expects "{platform}/{configurationId}" (or the equivalent identity),
+// because the API namespaces configurations by platform and the id alone does
+// not say which list to read.
 func resourceFronteggAssociatedDomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	platform, id, found := strings.Cut(d.Id(), "/")
-	if !found || (platform != "ios" && platform != "android") || id == "" {
-		return nil, fmt.Errorf("import id must be \"ios/{configurationId}\" or \"android/{configurationId}\", got %q", d.Id())
+	ids, err := importIdentity(d, "/", "platform", "configuration_id")
+	if err != nil {
+		return nil, err
+	}
+	platform := ids["platform"]
+	if platform != "ios" && platform != "android" {
+		return nil, fmt.Errorf("import platform must be \"ios\" or \"android\", got %q", platform)
 	}
 	if err := d.Set("platform", platform); err != nil {
 		return nil, err
 	}
-	d.SetId(id)
+	d.SetId(ids["configuration_id"])
 	return []*schema.ResourceData{d}, nil
 }
@@ -35,17 +35,17 @@ func resourceFronteggAuthPolicy() *schema.Resource {
 This is a singleton resource. You must only create one frontegg_auth_policy resource
 per Frontegg provider.
 
-**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the authentication policy will remain in its last-applied state.`,
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the authentication policy will remain in its last-applied state, unless ` + "`on_destroy = \"reset\"`" + ` is set.`,
 
 		CreateContext: resourceFronteggAuthPolicyCreate,
 		ReadContext:   resourceFronteggAuthPolicyRead,
 		UpdateContext: resourceFronteggAuthPolicyUpdate,
 		DeleteContext: resourceFronteggAuthPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      onDestroyImporter(resourceFronteggAuthPolicy),
 
 		Schema: map[string]*schema.Schema{
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
 			"allow_unverified_users": {
 				Description: "Whether unverified users are allowed to log in. This is the email verification control: " +
 					"set to `false` to require email verification (a verification link is sent to the user's email " +
@@ -182,10 +182,16 @@ func resourceFronteggAuthPolicyDeserialize(d *schema.ResourceData, f fronteggAut
 }
 
 func resourceFronteggAuthPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := captureOnDestroySnapshot(ctx, resourceFronteggAuthPolicy(), d, meta); diags.HasError() {
+		return diags
+	}
 	return resourceFronteggAuthPolicyUpdate(ctx, d, meta)
 }
 
 func resourceFronteggAuthPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := preserveOnDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	var out fronteggAuthPolicy
 	if err := clientHolder.ApiClient.Get(ctx, fronteggAuthPolicyURL, &out); err != nil {
@@ -207,6 +213,9 @@ func resourceFronteggAuthPolicyUpdate(ctx context.Context, d *schema.ResourceDat
 }
 
 func resourceFronteggAuthPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("on_destroy").(string) == onDestroyReset {
+		return resetFromOnDestroySnapshot(ctx, resourceFronteggAuthPolicy(), d, meta)
+	}
 	// Auth policy is a configuration that cannot be deleted, only reset to defaults
 	// We'll leave it in its current state and just remove from Terraform state
 	return nil
@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/frontegg/terraform-provider-frontegg/internal/waiter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFronteggCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		Description: `Configures a custom domain at which Frontegg services will be reachable.
+
+Create the DNS ` + "`records`" + ` at your DNS provider so that Frontegg can verify the domain, and use
+` + "`frontegg_custom_domain_verification`" + ` to wait for the verification. Do not list the same domain in
+` + "`frontegg_workspace.custom_domains`" + ` as well.
+
+` + "`wait_for_status`" + ` can wait for the verification in this resource instead, but a create that times out taints the
+domain, and the next apply deletes and recreates it along with new ` + "`records`" + `.`,
+
+		CreateContext: resourceFronteggCustomDomainCreate,
+		ReadContext:   resourceFronteggCustomDomainRead,
+		UpdateContext: resourceFronteggCustomDomainUpdate,
+		DeleteContext: resourceFronteggCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Description: "The custom domain, e.g. `auth.example.com`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"status": {
+				Description: "The verification status of the domain: `Pending`, `Active` or `Inactive`.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"records": {
+				Description: "The DNS records to create for the domain to be verified.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description: "The DNS record type, e.g. `CNAME`.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The DNS record name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"value": {
+							Description: "The DNS record value.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"wait_for_status": {
+				Description: `If set to ` + "`Active`" + `, wait until Frontegg reports the domain as verified. The wait is bounded by the create
+and update timeouts. Since ` + "`records`" + ` is only known once the domain exists, enable this when the DNS records are
+created without referencing this resource (for example a CNAME to ` + "`data.frontegg_workspace.frontegg_domain`" + `),
+or turn it on in a later apply after the records have been published. Prefer
+` + "`frontegg_custom_domain_verification`" + `, which keeps the domain when the wait times out.`,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(Active)}, false),
+			},
+		},
+	}
+}
+
+// findFronteggCustomDomain returns the custom domain with id, or else the one
+// named domain if it is set, or nil.
+func findFronteggCustomDomain(domains []fronteggCustomDomain, id, domain string) *fronteggCustomDomain {
+	for i := range domains {
+		if id != "" && domains[i].ID == id {
+			return &domains[i]
+		}
+	}
+	for i := range domains {
+		if domain != "" && domains[i].CustomDomain == domain {
+			return &domains[i]
+		}
+	}
+	return nil
+}
+
+func resourceFronteggCustomDomainDeserialize(d *schema.ResourceData, f fronteggCustomDomain) error {
+	d.SetId(f.ID)
+	if err := d.Set("domain", f.CustomDomain); err != nil {
+		return err
+	}
+	if err := d.Set("status", f.Status); err != nil {
+		return err
+	}
+	records := make([]interface{}, 0, len(f.Records))
+	for _, r := range f.Records {
+		records = append(records, map[string]interface{}{
+			"type":  r.Type,
+			"name":  r.Name,
+			"value": r.Value,
+		})
+	}
+	if err := d.Set("records", records); err != nil {
+		return err
+	}
+	// wait_for_status only affects applies; keep the configured value.
+	if err := d.Set("wait_for_status", d.Get("wait_for_status").(string)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceFronteggCustomDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	domain := d.Get("domain").(string)
+	in := fronteggCustomDomainCreate{CustomDomain: domain}
+	var out fronteggCustomDomain
+	if err := clientHolder.ApiClient.Post(ctx, fronteggCustomDomainURL, in, &out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Resolve the ID from the list if the response does not carry it.
+	if out.ID == "" {
+		domains, err := fetchFronteggCustomDomains(ctx, clientHolder)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		found := findFronteggCustomDomain(domains.CustomDomains, "", domain)
+		if found == nil {
+			return diag.Errorf("custom domain %q not found after creating it", domain)
+		}
+		out = *found
+	}
+	if err := resourceFronteggCustomDomainDeserialize(d, out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.Get("wait_for_status").(string) != "" {
+		if diags := resourceFronteggCustomDomainWait(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+	return resourceFronteggCustomDomainRead(ctx, d, meta)
+}
+
+func resourceFronteggCustomDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	domains, err := fetchFronteggCustomDomains(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	found := findFronteggCustomDomain(domains.CustomDomains, d.Id(), d.Get("domain").(string))
+	if found == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := resourceFronteggCustomDomainDeserialize(d, *found); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceFronteggCustomDomainUpdate only handles wait_for_status; the domain
+// forces a new resource.
+func resourceFronteggCustomDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("wait_for_status") && d.Get("wait_for_status").(string) != "" {
+		if diags := resourceFronteggCustomDomainWait(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+	return resourceFronteggCustomDomainRead(ctx, d, meta)
+}
+
+func resourceFronteggCustomDomainWait(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	status := fronteggCustomDomainStatus(d.Get("wait_for_status").(string))
+	if _, err := waiter.Wait(ctx, &clientHolder.ApiClient, fronteggCustomDomainStatusWait(d.Id(), status)); err != nil {
+		return waiter.Diagnostics(err)
+	}
+	return nil
+}
+
+func resourceFronteggCustomDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	if err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggCustomDomainURL, d.Id()), nil); err != nil {
+		if restclient.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
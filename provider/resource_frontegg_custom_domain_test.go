@@ -0,0 +1,184 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestFindFronteggCustomDomain(t *testing.T) {
+	domains := []fronteggCustomDomain{
+		{ID: "cd-1", CustomDomain: "auth.example.com"},
+		{ID: "cd-2", CustomDomain: "login.example.com"},
+	}
+	if got := findFronteggCustomDomain(domains, "cd-2", "auth.example.com"); got == nil || got.ID != "cd-2" {
+		t.Errorf("expected the ID to take precedence, got %+v", got)
+	}
+	if got := findFronteggCustomDomain(domains, "", "auth.example.com"); got == nil || got.ID != "cd-1" {
+		t.Errorf("expected a match by domain, got %+v", got)
+	}
+	if got := findFronteggCustomDomain(domains, "cd-3", "missing.example.com"); got != nil {
+		t.Errorf("expected no match, got %+v", got)
+	}
+	if got := findFronteggCustomDomain(append(domains, fronteggCustomDomain{ID: "cd-4"}), "cd-3", ""); got != nil {
+		t.Errorf("expected no match by an empty domain, got %+v", got)
+	}
+}
+
+// A frontegg_custom_domain must survive a frontegg_workspace managing other
+// custom domains, or not managing any.
+func TestFronteggWorkspaceCustomDomainsLeaveOtherDomainsAlone(t *testing.T) {
+	existing := []fronteggCustomDomain{
+		{ID: "cd-1", CustomDomain: "app.example.com"},
+		// Managed by a frontegg_custom_domain.
+		{ID: "cd-2", CustomDomain: "auth.example.com"},
+	}
+
+	if got := managedFronteggCustomDomains(existing, nil); len(got) != 0 {
+		t.Errorf("expected a workspace without custom_domains to track none, got %v", got)
+	}
+	if got := managedFronteggCustomDomains(existing, []string{"app.example.com"}); !reflect.DeepEqual(got, []string{"app.example.com"}) {
+		t.Errorf("expected only the workspace's own domain, got %v", got)
+	}
+
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(fronteggCustomDomains{CustomDomains: existing})
+		}
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+
+	// Adding a domain to the workspace creates only that one.
+	if err := syncFronteggWorkspaceCustomDomains(context.Background(), holder,
+		[]string{"app.example.com"}, []string{"app.example.com", "login.example.com"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Removing every domain from the workspace deletes only its own.
+	if err := syncFronteggWorkspaceCustomDomains(context.Background(), holder,
+		[]string{"app.example.com"}, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"GET " + fronteggCustomDomainURL,
+		"POST " + fronteggCustomDomainURL + "/" + fronteggCustomDomainCreateEndpoint,
+		"GET " + fronteggCustomDomainURL,
+		"DELETE " + fronteggCustomDomainURL + "/cd-1",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
+
+func TestFronteggWorkspaceEmptyCustomDomainsRemovesManagedDomains(t *testing.T) {
+	sm := schema.InternalMap(map[string]*schema.Schema{
+		"custom_domains": resourceFronteggWorkspace().Schema["custom_domains"],
+	})
+	state := &terraform.InstanceState{ID: "workspace", Attributes: map[string]string{
+		"custom_domains.#": "1",
+		"custom_domains.0": "app.example.com",
+	}}
+	for name, config := range map[string]map[string]interface{}{
+		"empty": {"custom_domains": []interface{}{}},
+		"unset": {},
+	} {
+		diff, err := sm.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil, nil, false)
+		if err != nil {
+			t.Fatalf("%s: diff: %v", name, err)
+		}
+		if diff == nil || diff.Attributes["custom_domains.#"] == nil || diff.Attributes["custom_domains.#"].New != "0" {
+			t.Errorf("%s: expected the last managed domain to be removed, got %#v", name, diff)
+		}
+	}
+}
+
+func TestFronteggWorkspaceCustomDomainsResetRestoresSnapshot(t *testing.T) {
+	remote := map[string]string{"cd-1": "legacy.example.com"}
+	nextID := 2
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet:
+			var out fronteggCustomDomains
+			for id, domain := range remote {
+				out.CustomDomains = append(out.CustomDomains, fronteggCustomDomain{ID: id, CustomDomain: domain})
+			}
+			_ = json.NewEncoder(w).Encode(out)
+		case r.Method == http.MethodPost:
+			var in fronteggCustomDomainCreate
+			_ = json.NewDecoder(r.Body).Decode(&in)
+			remote[fmt.Sprintf("cd-%d", nextID)] = in.CustomDomain
+			nextID++
+		case r.Method == http.MethodDelete:
+			delete(remote, strings.TrimPrefix(r.URL.Path, fronteggCustomDomainURL+"/"))
+		}
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+
+	// A workspace reduced to custom_domains, with its Read and Update.
+	r := &schema.Resource{
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			existing, err := fetchFronteggCustomDomains(ctx, holder)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			return diag.FromErr(d.Set("custom_domains", fronteggWorkspaceCustomDomainsState(d, existing.CustomDomains)))
+		},
+		UpdateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			olds, news := d.GetChange("custom_domains")
+			return diag.FromErr(syncFronteggWorkspaceCustomDomains(ctx, holder, stringSetToList(olds.(*schema.Set)), stringSetToList(news.(*schema.Set))))
+		},
+		Schema: map[string]*schema.Schema{
+			"custom_domains":      resourceFronteggWorkspace().Schema["custom_domains"],
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"custom_domains": []interface{}{"app.example.com"},
+		"on_destroy":     onDestroyReset,
+	})
+	d.SetId("workspace")
+	if diags := captureOnDestroySnapshot(context.Background(), r, d, nil); diags.HasError() {
+		t.Fatalf("capture: %v", diags)
+	}
+	if !strings.Contains(d.Get("on_destroy_snapshot").(string), "legacy.example.com") {
+		t.Fatalf("expected the snapshot to record the existing domain: %s", d.Get("on_destroy_snapshot"))
+	}
+
+	// Simulate the apply adding the managed domain.
+	if err := syncFronteggWorkspaceCustomDomains(context.Background(), holder, nil, []string{"app.example.com"}); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	if diags := resetFromOnDestroySnapshot(context.Background(), r, d, nil); diags.HasError() {
+		t.Fatalf("reset: %v", diags)
+	}
+	var got []string
+	for _, domain := range remote {
+		got = append(got, domain)
+	}
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, []string{"legacy.example.com"}) {
+		t.Errorf("domains after reset = %v", got)
+	}
+}
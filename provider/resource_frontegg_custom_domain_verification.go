@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/frontegg/terraform-provider-frontegg/internal/waiter"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFronteggCustomDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		Description: `Waits until Frontegg has verified a ` + "`frontegg_custom_domain`" + `.
+
+This resource does not call Frontegg to create anything. Make it depend on the DNS records built from the domain's
+` + "`records`" + `, so that the domain is created first, then the records, then the verification is awaited. If the
+wait times out, only this resource is tainted, and the domain and its records are kept.`,
+
+		CreateContext: resourceFronteggCustomDomainVerificationCreate,
+		ReadContext:   resourceFronteggCustomDomainVerificationRead,
+		DeleteContext: resourceFronteggCustomDomainVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+			Read:   schema.DefaultTimeout(fronteggDefaultTimeout),
+			Delete: schema.DefaultTimeout(fronteggDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"custom_domain_id": {
+				Description: "The ID of the custom domain to wait for, e.g. `frontegg_custom_domain.example.id`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain": {
+				Description: "The custom domain.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceFronteggCustomDomainVerificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	id := d.Get("custom_domain_id").(string)
+	out, err := waiter.Wait(ctx, &clientHolder.ApiClient, fronteggCustomDomainStatusWait(id, Active))
+	if err != nil {
+		return waiter.Diagnostics(err)
+	}
+	d.SetId(id)
+	if found := findFronteggCustomDomain(out.CustomDomains, id, ""); found != nil {
+		if err := d.Set("domain", found.CustomDomain); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return nil
+}
+
+func resourceFronteggCustomDomainVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	domains, err := fetchFronteggCustomDomains(ctx, clientHolder)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// A domain that is gone or no longer active needs verifying again.
+	found := findFronteggCustomDomain(domains.CustomDomains, d.Id(), "")
+	if found == nil || found.Status != string(Active) {
+		log.Printf("[WARN] Custom domain %s is no longer verified, removing its verification from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("domain", found.CustomDomain); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceFronteggCustomDomainVerificationDelete only removes the verification
+// from state; the domain is deleted with its frontegg_custom_domain.
+func resourceFronteggCustomDomainVerificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+// fronteggCustomDomainStatusWait waits until the custom domain with id reports
+// status.
+func fronteggCustomDomainStatusWait(id string, status fronteggCustomDomainStatus) waiter.Config[fronteggCustomDomains] {
+	return waiter.Config[fronteggCustomDomains]{
+		Description: fmt.Sprintf("custom domain %s to become %s", id, status),
+		Path:        fronteggCustomDomainURL,
+		Check: func(out *fronteggCustomDomains) (waiter.Result, error) {
+			found := findFronteggCustomDomain(out.CustomDomains, id, "")
+			if found == nil {
+				return waiter.Result{}, fmt.Errorf("custom domain %s not found", id)
+			}
+			return waiter.Result{Status: found.Status, Done: found.Status == string(status)}, nil
+		},
+	}
+}
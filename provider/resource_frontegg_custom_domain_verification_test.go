@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFronteggCustomDomainStatusWaitCheck(t *testing.T) {
+	check := fronteggCustomDomainStatusWait("cd-2", Active).Check
+
+	res, err := check(&fronteggCustomDomains{CustomDomains: []fronteggCustomDomain{
+		{ID: "cd-1", CustomDomain: "other.example.com", Status: string(Active)},
+		{ID: "cd-2", CustomDomain: "auth.example.com", Status: string(Pending)},
+	}})
+	if err != nil || res.Done || res.Status != string(Pending) {
+		t.Errorf("pending domain: got %+v, %v", res, err)
+	}
+
+	res, err = check(&fronteggCustomDomains{CustomDomains: []fronteggCustomDomain{
+		{ID: "cd-2", CustomDomain: "auth.example.com", Status: string(Active)},
+	}})
+	if err != nil || !res.Done {
+		t.Errorf("active domain: got %+v, %v", res, err)
+	}
+
+	if _, err := check(&fronteggCustomDomains{}); err == nil || !strings.Contains(err.Error(), "cd-2") {
+		t.Errorf("missing domain: expected an error naming it, got %v", err)
+	}
+}
@@ -66,7 +66,7 @@ func resourceFronteggEntitlement() *schema.Resource {
 	return &schema.Resource{
 		Description: `Assigns Frontegg entitlement plans to tenants and/or users in bulk via a single batch-actions API call.
 
-**Import caveat:** ` + "`by-tenant:<id>`" + ` and ` + "`by-plan:<id>`" + ` import formats absorb ALL matching entitlements server-side, regardless of source. Review the imported state before the next apply.
+**Import caveat:** ` + "`by-tenant:<id>`" + ` and ` + "`by-plan:<id>`" + ` import formats (and an ` + "`identity`" + ` with ` + "`tenant_id`" + ` or ` + "`plan_id`" + `) absorb ALL matching entitlements server-side, regardless of source. Review the imported state before the next apply.
 
 **Partial failure recovery:** If an apply exceeds ` + fmt.Sprintf("%d", maxBatchActionsSize) + ` total actions it is chunked (creates → updates → deletes across chunks). On partial failure, already-committed chunks are not rolled back; ` + "`terraform refresh`" + ` + re-apply reconciles.`,
 
@@ -77,6 +77,43 @@ func resourceFronteggEntitlement() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceFronteggEntitlementImport,
 		},
+		// Large sets are applied in chunks of batch actions, each of which may
+		// have to wait out the rate limiter.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Read:   schema.DefaultTimeout(fronteggDefaultTimeout),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+		// Only tenant_id and plan_id are written to state; entitlement_ids is
+		// an import-time selector, since the IDs change with every assignment
+		// added or removed. The identity stays mutable because a shared tenant
+		// or plan is cleared once the set spans several of them.
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id": {
+						Description:       "Import every entitlement of this tenant. Set in state when all managed entitlements share a tenant.",
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					},
+					"plan_id": {
+						Description:       "Import every entitlement of this plan. Set in state when all managed entitlements share a plan.",
+						Type:              schema.TypeString,
+						OptionalForImport: true,
+					},
+					"entitlement_ids": {
+						Description:       "Import exactly these entitlements. Cannot be combined with `tenant_id` or `plan_id`. Only used for import; never set in state.",
+						Type:              schema.TypeList,
+						OptionalForImport: true,
+						Elem:              &schema.Schema{Type: schema.TypeString},
+					},
+				}
+			},
+		},
+		ResourceBehavior: schema.ResourceBehavior{
+			MutableIdentity: true,
+		},
 		CustomizeDiff: resourceFronteggEntitlementCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
@@ -371,9 +408,40 @@ func hydrateEntitlementSet(ctx context.Context, d *schema.ResourceData, c *restc
 	if err := d.Set("entitlement", newBlocks); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := resourceFronteggEntitlementSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
+// resourceFronteggEntitlementSetIdentity derives the identity from the
+// entitlement set: the tenant or plan when every entitlement shares it.
+func resourceFronteggEntitlementSetIdentity(d *schema.ResourceData) error {
+	tenants := map[string]bool{}
+	plans := map[string]bool{}
+	for _, item := range d.Get("entitlement").(*schema.Set).List() {
+		m := item.(map[string]interface{})
+		tenants[m["tenant_id"].(string)] = true
+		plans[m["plan_id"].(string)] = true
+	}
+
+	values := map[string]interface{}{
+		"tenant_id": "",
+		"plan_id":   "",
+	}
+	if len(tenants) == 1 {
+		for tenantID := range tenants {
+			values["tenant_id"] = tenantID
+		}
+	}
+	if len(plans) == 1 {
+		for planID := range plans {
+			values["plan_id"] = planID
+		}
+	}
+	return setIdentity(d, values)
+}
+
 func entitlementToBlock(e *fronteggEntitlement) map[string]interface{} {
 	return map[string]interface{}{
 		"plan_id":         e.PlanID,
@@ -417,6 +485,9 @@ func resourceFronteggEntitlementRead(ctx context.Context, d *schema.ResourceData
 	if err := d.Set("entitlement", newBlocks); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := resourceFronteggEntitlementSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
@@ -545,6 +616,9 @@ func resourceFronteggEntitlementUpdate(ctx context.Context, d *schema.ResourceDa
 	if err := d.Set("entitlement", hydrated); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := resourceFronteggEntitlementSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
@@ -577,6 +651,9 @@ func resourceFronteggEntitlementImport(ctx context.Context, d *schema.ResourceDa
 	var err error
 
 	switch {
+	case raw == "":
+		ids, err = resourceFronteggEntitlementImportIdentityIDs(ctx, c, d)
+		raw = "import identity"
 	case strings.HasPrefix(raw, "by-tenant:"):
 		tenantID := strings.TrimPrefix(raw, "by-tenant:")
 		if tenantID == "" {
@@ -619,6 +696,44 @@ func resourceFronteggEntitlementImport(ctx context.Context, d *schema.ResourceDa
 	return []*schema.ResourceData{d}, nil
 }
 
+// resourceFronteggEntitlementImportIdentityIDs resolves the entitlement IDs for
+// an import block that uses `identity` instead of a string ID. tenant_id and
+// plan_id may be combined (both filters apply); entitlement_ids stands alone.
+func resourceFronteggEntitlementImportIdentityIDs(ctx context.Context, c *restclient.Client, d *schema.ResourceData) ([]string, error) {
+	identity, err := d.Identity()
+	if err != nil {
+		return nil, err
+	}
+	tenantID, _ := identity.Get("tenant_id").(string)
+	planID, _ := identity.Get("plan_id").(string)
+	var explicitIDs []string
+	if raw, ok := identity.Get("entitlement_ids").([]interface{}); ok {
+		for _, v := range raw {
+			if id, _ := v.(string); id != "" {
+				explicitIDs = append(explicitIDs, id)
+			}
+		}
+	}
+
+	switch {
+	case len(explicitIDs) > 0 && (tenantID != "" || planID != ""):
+		return nil, fmt.Errorf("import identity entitlement_ids cannot be combined with tenant_id or plan_id")
+	case len(explicitIDs) > 0:
+		return explicitIDs, nil
+	case tenantID == "" && planID == "":
+		return nil, fmt.Errorf("import identity requires one of tenant_id, plan_id or entitlement_ids")
+	}
+
+	filters := url.Values{}
+	if tenantID != "" {
+		filters.Set("tenantIds", tenantID)
+	}
+	if planID != "" {
+		filters.Set("planIds", planID)
+	}
+	return listEntitlementIDs(ctx, c, filters)
+}
+
 type fronteggEntitlementListPage struct {
 	Items   []fronteggEntitlement `json:"items"`
 	HasNext bool                  `json:"hasNext"`
@@ -0,0 +1,285 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const fronteggGroupPath = "/identity/resources/groups/v1"
+
+type fronteggGroupRelation struct {
+	ID string `json:"id"`
+}
+
+type fronteggGroup struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Color       string `json:"color,omitempty"`
+	// Metadata is a jsonified-string, as on tenants.
+	Metadata string                  `json:"metadata,omitempty"`
+	Roles    []fronteggGroupRelation `json:"roles,omitempty"`
+	Users    []fronteggGroupRelation `json:"users,omitempty"`
+}
+
+type fronteggGroupRoleIDs struct {
+	RoleIDs []string `json:"roleIds"`
+}
+
+type fronteggGroupUserIDs struct {
+	UserIDs []string `json:"userIds"`
+}
+
+func resourceFronteggGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: `Configures a group of users in a Frontegg tenant. Roles assigned to a group are granted to all of its
+members. Groups must be enabled in the admin portal (` + "`enable_groups`" + ` on ` + "`frontegg_admin_portal`" + `) for
+tenants to see them.`,
+
+		CreateContext: resourceFronteggGroupCreate,
+		ReadContext:   resourceFronteggGroupRead,
+		UpdateContext: resourceFronteggGroupUpdate,
+		DeleteContext: resourceFronteggGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFronteggGroupImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id": requiredIdentityAttribute("The ID of the tenant that owns the group."),
+					"group_id":  requiredIdentityAttribute("The ID of the group."),
+				}
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant that owns the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "A human-readable description of the group.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"color": {
+				Description: "The color the group is shown with in the admin portal, as a hex code such as `#4F46E5`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ValidateFunc: validation.StringMatch(
+					regexp.MustCompile(`^#[0-9a-fA-F]{6}$`),
+					"color must be a hex code such as #4F46E5",
+				),
+			},
+			"metadata": {
+				Description: "Metadata for the group.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"role_ids": {
+				Description: "The IDs of the roles granted to the members of the group.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceFronteggGroupSerialize(d *schema.ResourceData) (fronteggGroup, error) {
+	group := fronteggGroup{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Color:       d.Get("color").(string),
+	}
+	metadata := castResourceStringMap(d.Get("metadata"))
+	if len(metadata) > 0 {
+		encoded, err := json.Marshal(metadata)
+		if err != nil {
+			return fronteggGroup{}, err
+		}
+		group.Metadata = string(encoded)
+	}
+	return group, nil
+}
+
+func resourceFronteggGroupDeserialize(d *schema.ResourceData, f fronteggGroup) error {
+	d.SetId(f.ID)
+	if err := d.Set("name", f.Name); err != nil {
+		return err
+	}
+	if err := d.Set("description", f.Description); err != nil {
+		return err
+	}
+	if err := d.Set("color", f.Color); err != nil {
+		return err
+	}
+	metadata, err := decodeFronteggMetadata(f.Metadata)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return err
+	}
+	roleIDs := make([]string, 0, len(f.Roles))
+	for _, role := range f.Roles {
+		roleIDs = append(roleIDs, role.ID)
+	}
+	if err := d.Set("role_ids", roleIDs); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceFronteggGroupSetIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id": d.Get("tenant_id").(string),
+		"group_id":  d.Id(),
+	})
+}
+
+// fetchFronteggGroup returns the group with its roles and users, or nil if it
+// does not exist.
+func fetchFronteggGroup(ctx context.Context, clientHolder *restclient.ClientHolder, groupID string, headers http.Header) (*fronteggGroup, error) {
+	var out fronteggGroup
+	path := fmt.Sprintf("%s/%s?_groupsRelations=rolesAndUsers", fronteggGroupPath, groupID)
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, path, headers, &out); err != nil {
+		if restclient.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &out, nil
+}
+
+// updateFronteggGroupRoles adds and removes group roles to go from olds to news.
+func updateFronteggGroupRoles(ctx context.Context, clientHolder *restclient.ClientHolder, groupID string, headers http.Header, olds, news *schema.Set) error {
+	path := fmt.Sprintf("%s/%s/roles", fronteggGroupPath, groupID)
+	if toAdd := stringSetToList(news.Difference(olds)); len(toAdd) > 0 {
+		if err := clientHolder.ApiClient.PostWithHeaders(ctx, path, headers, fronteggGroupRoleIDs{toAdd}, nil); err != nil {
+			return err
+		}
+	}
+	if toDel := stringSetToList(olds.Difference(news)); len(toDel) > 0 {
+		if err := clientHolder.ApiClient.RequestWithHeaders(ctx, "DELETE", path, headers, fronteggGroupRoleIDs{toDel}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFronteggGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	in, err := resourceFronteggGroupSerialize(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out fronteggGroup
+	if err := clientHolder.ApiClient.PostWithHeaders(ctx, fronteggGroupPath, headers, in, &out); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(out.ID)
+	if err := resourceFronteggGroupSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	roleIDs := d.Get("role_ids").(*schema.Set)
+	if err := updateFronteggGroupRoles(ctx, clientHolder, out.ID, headers, schema.NewSet(schema.HashString, nil), roleIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFronteggGroupRead(ctx, d, meta)
+}
+
+func resourceFronteggGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	out, err := fetchFronteggGroup(ctx, clientHolder, d.Id(), headers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if out == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := resourceFronteggGroupDeserialize(d, *out); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggGroupSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChanges("name", "description", "color", "metadata") {
+		in, err := resourceFronteggGroupSerialize(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err := clientHolder.ApiClient.PatchWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggGroupPath, d.Id()), headers, in, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if d.HasChange("role_ids") {
+		olds, news := d.GetChange("role_ids")
+		if err := updateFronteggGroupRoles(ctx, clientHolder, d.Id(), headers, olds.(*schema.Set), news.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceFronteggGroupRead(ctx, d, meta)
+}
+
+func resourceFronteggGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := clientHolder.ApiClient.DeleteWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggGroupPath, d.Id()), headers, nil); err != nil {
+		if restclient.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggGroupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := importIdentity(d, ":", "tenant_id", "group_id")
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(ids["group_id"])
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceFronteggGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: `Adds users to a Frontegg group.
+
+This resource is non-authoritative: it only adds and removes the users listed in ` + "`user_ids`" + `. Members added
+outside of this resource, for example by tenant admins in the admin portal, are left untouched. Importing adopts every
+current member of the group.`,
+
+		CreateContext: resourceFronteggGroupMembershipCreate,
+		ReadContext:   resourceFronteggGroupMembershipRead,
+		UpdateContext: resourceFronteggGroupMembershipUpdate,
+		DeleteContext: resourceFronteggGroupMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFronteggGroupMembershipImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id": requiredIdentityAttribute("The ID of the tenant that owns the group."),
+					"group_id":  requiredIdentityAttribute("The ID of the group."),
+				}
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant that owns the group.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group_id": {
+				Description: "The ID of the group, e.g. `frontegg_group.example.id`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"user_ids": {
+				Description: "The IDs of the users to add to the group. The users must be members of the tenant.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceFronteggGroupMembershipSetIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id": d.Get("tenant_id").(string),
+		"group_id":  d.Get("group_id").(string),
+	})
+}
+
+// managedFronteggGroupMembers returns the users of managed that are members of
+// the group.
+func managedFronteggGroupMembers(members []fronteggGroupRelation, managed *schema.Set) []string {
+	out := []string{}
+	for _, member := range members {
+		if managed.Contains(member.ID) {
+			out = append(out, member.ID)
+		}
+	}
+	return out
+}
+
+func updateFronteggGroupUsers(ctx context.Context, clientHolder *restclient.ClientHolder, groupID string, headers http.Header, olds, news *schema.Set) error {
+	path := fmt.Sprintf("%s/%s/users", fronteggGroupPath, groupID)
+	if toAdd := stringSetToList(news.Difference(olds)); len(toAdd) > 0 {
+		if err := clientHolder.ApiClient.PostWithHeaders(ctx, path, headers, fronteggGroupUserIDs{toAdd}, nil); err != nil {
+			return err
+		}
+	}
+	if toDel := stringSetToList(olds.Difference(news)); len(toDel) > 0 {
+		if err := clientHolder.ApiClient.RequestWithHeaders(ctx, "DELETE", path, headers, fronteggGroupUserIDs{toDel}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFronteggGroupMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	groupID := d.Get("group_id").(string)
+	if err := updateFronteggGroupUsers(ctx, clientHolder, groupID, headers, schema.NewSet(schema.HashString, nil), d.Get("user_ids").(*schema.Set)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("tenant_id").(string), groupID))
+	return resourceFronteggGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceFronteggGroupMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	group, err := fetchFronteggGroup(ctx, clientHolder, d.Get("group_id").(string), headers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if group == nil {
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("user_ids", managedFronteggGroupMembers(group.Users, d.Get("user_ids").(*schema.Set))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggGroupMembershipSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggGroupMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	olds, news := d.GetChange("user_ids")
+	if err := updateFronteggGroupUsers(ctx, clientHolder, d.Get("group_id").(string), headers, olds.(*schema.Set), news.(*schema.Set)); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFronteggGroupMembershipRead(ctx, d, meta)
+}
+
+func resourceFronteggGroupMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := tenantSSOHeaders(d.Get("tenant_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := updateFronteggGroupUsers(ctx, clientHolder, d.Get("group_id").(string), headers, d.Get("user_ids").(*schema.Set), schema.NewSet(schema.HashString, nil)); err != nil {
+		if restclient.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceFronteggGroupMembershipImport adopts every current member of the
+// group, since an imported membership has no managed users to intersect with.
+func resourceFronteggGroupMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clientHolder := meta.(*restclient.ClientHolder)
+	ids, err := importIdentity(d, ":", "tenant_id", "group_id")
+	if err != nil {
+		return nil, err
+	}
+	headers, err := tenantSSOHeaders(ids["tenant_id"])
+	if err != nil {
+		return nil, err
+	}
+	group, err := fetchFronteggGroup(ctx, clientHolder, ids["group_id"], headers)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("unable to find group %q in tenant %q", ids["group_id"], ids["tenant_id"])
+	}
+	userIDs := make([]string, 0, len(group.Users))
+	for _, member := range group.Users {
+		userIDs = append(userIDs, member.ID)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", ids["tenant_id"], ids["group_id"]))
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("group_id", ids["group_id"]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("user_ids", userIDs); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGroupSerializeRoundTrip(t *testing.T) {
+	r := resourceFronteggGroup()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tenant_id":   "tenant-1",
+		"name":        "Engineering",
+		"description": "Everyone in engineering",
+		"color":       "#4F46E5",
+		"metadata":    map[string]interface{}{"cost_center": "42"},
+		"role_ids":    []interface{}{"role-1"},
+	})
+	in, err := resourceFronteggGroupSerialize(d)
+	if err != nil {
+		t.Fatalf("serialize: %v", err)
+	}
+	if in.Name != "Engineering" || in.Color != "#4F46E5" || in.Metadata != `{"cost_center":"42"}` {
+		t.Errorf("unexpected serialized group: %+v", in)
+	}
+
+	out := in
+	out.ID = "group-1"
+	out.Roles = []fronteggGroupRelation{{ID: "role-1"}, {ID: "role-2"}}
+	read := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"tenant_id": "tenant-1"})
+	if err := resourceFronteggGroupDeserialize(read, out); err != nil {
+		t.Fatalf("deserialize: %v", err)
+	}
+	roleIDs := stringSetToList(read.Get("role_ids").(*schema.Set))
+	sort.Strings(roleIDs)
+	if read.Id() != "group-1" || !reflect.DeepEqual(roleIDs, []string{"role-1", "role-2"}) {
+		t.Errorf("unexpected state: id=%q role_ids=%v", read.Id(), roleIDs)
+	}
+	if got := read.Get("metadata").(map[string]interface{}); got["cost_center"] != "42" {
+		t.Errorf("metadata = %v", got)
+	}
+}
+
+func TestManagedFronteggGroupMembers(t *testing.T) {
+	members := []fronteggGroupRelation{{ID: "u1"}, {ID: "u2"}, {ID: "u3"}}
+
+	managed := schema.NewSet(schema.HashString, []interface{}{"u1", "u3", "u4"})
+	if got := managedFronteggGroupMembers(members, managed); !reflect.DeepEqual(got, []string{"u1", "u3"}) {
+		t.Errorf("expected only managed members that are still in the group, got %v", got)
+	}
+
+	empty := schema.NewSet(schema.HashString, nil)
+	if got := managedFronteggGroupMembers(members, empty); len(got) != 0 {
+		t.Errorf("expected no members without managed users, got %v", got)
+	}
+}
+
+func TestFronteggGroupMembershipImportAdoptsMembers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != fronteggGroupPath+"/group-1" || r.Header.Get("frontegg-tenant-id") != "tenant-1" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		_ = json.NewEncoder(w).Encode(fronteggGroup{Users: []fronteggGroupRelation{{ID: "u1"}, {ID: "u2"}}})
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+
+	r := resourceFronteggGroupMembership()
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), nil)
+	d.SetId("tenant-1:group-1")
+	got, err := resourceFronteggGroupMembershipImport(context.Background(), d, holder)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	userIDs := stringSetToList(got[0].Get("user_ids").(*schema.Set))
+	sort.Strings(userIDs)
+	if !reflect.DeepEqual(userIDs, []string{"u1", "u2"}) {
+		t.Errorf("expected the import to adopt every member, got %v", userIDs)
+	}
+}
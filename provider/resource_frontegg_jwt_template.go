@@ -25,6 +25,10 @@ var fronteggJWTTemplateRequiredClaims = []string{"iss", "sub", "aud", "exp", "ia
 // allowed"). We reject them at plan time so the failure surfaces before apply.
 var fronteggJWTTemplateReservedClaims = []string{"type", "tenantId"}
 
+// fronteggJWTTemplateAlgorithms are the signing algorithms a JWT template can
+// use. Only asymmetric ones have public keys published in the JWKS.
+var fronteggJWTTemplateAlgorithms = []string{"RS256", "HS256"}
+
 type fronteggJWTTemplateSchema struct {
 	Claims map[string]interface{} `json:"claims"`
 }
@@ -77,13 +81,10 @@ func resourceFronteggJWTTemplate() *schema.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"algorithm": {
-				Description: "The JWT signing algorithm. Valid values are `RS256` and `HS256`.",
-				Type:        schema.TypeString,
-				Required:    true,
-				ValidateFunc: validation.StringInSlice([]string{
-					"RS256",
-					"HS256",
-				}, false),
+				Description:  "The JWT signing algorithm. Valid values are `RS256` and `HS256`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(fronteggJWTTemplateAlgorithms, false),
 			},
 			"claims": {
 				Description: "Key-value pairs representing the JWT claims included in the template.",
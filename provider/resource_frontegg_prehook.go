@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/frontegg/terraform-provider-frontegg/internal/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -25,11 +26,6 @@ const (
 const fronteggPrehookDefaultRuntime = "NODE_20"
 const fronteggPrehookDefaultTimeout = 10
 
-// A custom code prehook's executor (a serverless function) provisions
-// asynchronously, so writes made immediately after creation can briefly return
-// 5xx until it is ready. Retry those within this window.
-const fronteggPrehookProvisionTimeout = 2 * time.Minute
-
 type fronteggPrehook struct {
 	ID                 string   `json:"id,omitempty"`
 	Type               string   `json:"type,omitempty"`
@@ -68,6 +64,14 @@ per event, regardless of type.`,
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 		CustomizeDiff: resourceFronteggPrehookCustomizeDiff,
+		// Writes to a custom code prehook are retried while its executor
+		// provisions, for at most the create or update timeout.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(fronteggDefaultTimeout),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(fronteggDefaultTimeout),
+		},
 
 		Schema: map[string]*schema.Schema{
 			"enabled": {
@@ -130,7 +134,7 @@ per event, regardless of type.`,
 				Computed:    true,
 			},
 			"events": {
-				Description: "The name of the event to subscribe to.",
+				Description: "The name of the event to subscribe to. Checked at plan time against the `frontegg_event_catalog`.",
 				Type:        schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
@@ -157,7 +161,10 @@ type fronteggFieldGetter interface {
 }
 
 func resourceFronteggPrehookCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
-	return validateFronteggPrehookFields(d)
+	if err := validateFronteggPrehookFields(d); err != nil {
+		return err
+	}
+	return eventCatalogCustomizeDiff(ctx, d, m, fronteggEventKindPrehook)
 }
 
 func validateFronteggPrehookFields(d fronteggFieldGetter) error {
@@ -201,10 +208,12 @@ func fronteggPrehookIsTransientError(err error) bool {
 	return false
 }
 
-// fronteggPrehookRetry runs fn, retrying transient server errors until the
-// executor provisioning window elapses.
-func fronteggPrehookRetry(ctx context.Context, fn func() error) error {
-	return retry.RetryContext(ctx, fronteggPrehookProvisionTimeout, func() *retry.RetryError {
+// fronteggPrehookRetry runs fn, retrying transient server errors until timeout
+// elapses. A custom code prehook's executor (a serverless function) provisions
+// asynchronously, so writes made immediately after creation can briefly return
+// 5xx until it is ready.
+func fronteggPrehookRetry(ctx context.Context, timeout time.Duration, fn func() error) error {
+	return retry.RetryContext(ctx, timeout, func() *retry.RetryError {
 		err := fn()
 		if err == nil {
 			return nil
@@ -337,6 +346,22 @@ func resourceFronteggPrehookCheckEventConflict(ctx context.Context, clientHolder
 	return nil
 }
 
+// fronteggPrehookExecutorReadyWait waits until the custom code executor behind
+// a prehook can be read back. While it provisions, reads return 404 or a
+// transient server error.
+func fronteggPrehookExecutorReadyWait(executorIdentifier string) waiter.Config[fronteggCustomCode] {
+	return waiter.Config[fronteggCustomCode]{
+		Description: fmt.Sprintf("custom code executor %s to finish provisioning", executorIdentifier),
+		Path:        fmt.Sprintf("%s/%s", fronteggCustomCodePath, executorIdentifier),
+		Check: func(out *fronteggCustomCode) (waiter.Result, error) {
+			return waiter.Result{Done: true}, nil
+		},
+		Pending: func(err error) bool {
+			return restclient.IsNotFound(err) || fronteggPrehookIsTransientError(err)
+		},
+	}
+}
+
 // resourceFronteggPrehookFinalize writes the API response into state, fetching the
 // custom code content (runtime + source) when needed, since the prehook create,
 // update, and list responses carry only the executorIdentifier, not the code.
@@ -346,11 +371,11 @@ func resourceFronteggPrehookFinalize(ctx context.Context, clientHolder *restclie
 	}
 	var code *fronteggCustomCode
 	if out.Type == fronteggPrehookTypeCustomCode && out.ExecutorIdentifier != "" {
-		var fetched fronteggCustomCode
-		if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/%s", fronteggCustomCodePath, out.ExecutorIdentifier), &fetched); err != nil {
-			return diag.FromErr(err)
+		fetched, err := waiter.Wait(ctx, &clientHolder.ApiClient, fronteggPrehookExecutorReadyWait(out.ExecutorIdentifier))
+		if err != nil {
+			return waiter.Diagnostics(err)
 		}
-		code = &fetched
+		code = fetched
 	}
 	if err := resourceFronteggPrehookDeserialize(d, out, code); err != nil {
 		return diag.FromErr(err)
@@ -369,7 +394,7 @@ func resourceFronteggPrehookCreate(ctx context.Context, d *schema.ResourceData,
 	var out fronteggPrehook
 	if resourceFronteggPrehookIsCustomCode(d) {
 		in.ID = "create"
-		if err := fronteggPrehookRetry(ctx, func() error {
+		if err := fronteggPrehookRetry(ctx, d.Timeout(schema.TimeoutCreate), func() error {
 			return clientHolder.ApiClient.Post(ctx, fronteggPrehookCustomCodePath, in, &out)
 		}); err != nil {
 			return diag.FromErr(err)
@@ -380,7 +405,8 @@ func resourceFronteggPrehookCreate(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 
-	return resourceFronteggPrehookFinalize(ctx, clientHolder, d, out)
+	diags := resourceFronteggPrehookFinalize(ctx, clientHolder, d, out)
+	return append(diags, eventCatalogSkippedWarning(clientHolder, fronteggEventKindPrehook)...)
 }
 
 func resourceFronteggPrehookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -423,7 +449,7 @@ func resourceFronteggPrehookUpdate(ctx context.Context, d *schema.ResourceData,
 
 	var out fronteggPrehook
 	if resourceFronteggPrehookIsCustomCode(d) {
-		if err := fronteggPrehookRetry(ctx, func() error {
+		if err := fronteggPrehookRetry(ctx, d.Timeout(schema.TimeoutUpdate), func() error {
 			return clientHolder.ApiClient.Patch(ctx, fmt.Sprintf("%s/%s", fronteggPrehookCustomCodePath, d.Id()), in, &out)
 		}); err != nil {
 			return diag.FromErr(err)
@@ -437,7 +463,8 @@ func resourceFronteggPrehookUpdate(ctx context.Context, d *schema.ResourceData,
 	if out.ID == "" {
 		out.ID = d.Id()
 	}
-	return resourceFronteggPrehookFinalize(ctx, clientHolder, d, out)
+	diags := resourceFronteggPrehookFinalize(ctx, clientHolder, d, out)
+	return append(diags, eventCatalogSkippedWarning(clientHolder, fronteggEventKindPrehook)...)
 }
 
 func resourceFronteggPrehookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
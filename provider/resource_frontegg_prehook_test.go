@@ -2,12 +2,14 @@ package provider
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -215,6 +217,44 @@ resource "frontegg_prehook" "cc" {
 }
 `
 
+func TestPrehookRetryHonoursTimeout(t *testing.T) {
+	calls := 0
+	err := fronteggPrehookRetry(context.Background(), 2*time.Second, func() error {
+		calls++
+		return fmt.Errorf("503 Service Unavailable")
+	})
+	if err == nil {
+		t.Fatal("expected the retry to give up once the timeout elapsed")
+	}
+	if calls < 2 {
+		t.Errorf("transient error retried %d times, want at least 2", calls)
+	}
+
+	calls = 0
+	err = fronteggPrehookRetry(context.Background(), time.Minute, func() error {
+		calls++
+		return fmt.Errorf("400 Bad Request")
+	})
+	if err == nil || calls != 1 {
+		t.Errorf("non-transient error: calls = %d, err = %v; want 1 call and an error", calls, err)
+	}
+}
+
+func TestPrehookExecutorReadyWaitPending(t *testing.T) {
+	pending := fronteggPrehookExecutorReadyWait("exec-1").Pending
+	for _, msg := range []string{
+		"restclient: request failed: GET https://api/custom-code/resources/codes/v1/exec-1: 404 Not Found",
+		"restclient: request failed: GET https://api/custom-code/resources/codes/v1/exec-1: 503 Service Unavailable",
+	} {
+		if !pending(fmt.Errorf("%s", msg)) {
+			t.Errorf("expected %q to be treated as still provisioning", msg)
+		}
+	}
+	if pending(fmt.Errorf("restclient: request failed: GET https://api/x: 403 Forbidden")) {
+		t.Error("expected a 403 to stop the wait")
+	}
+}
+
 func TestAccFronteggPrehook_customCodeLifecycle(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:          func() { testAccPreCheck(t) },
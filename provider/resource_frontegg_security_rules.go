@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"log"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const fronteggSecurityRulesURL = "/identity/resources/configurations/v1/security-rules"
+
+// securityRulesID is the synthetic ID for this singleton resource.
+const securityRulesID = "security-rules"
+
+type fronteggSecurityRule struct {
+	Enabled bool   `json:"enabled"`
+	Action  string `json:"action,omitempty"`
+}
+
+type fronteggSecurityRules struct {
+	BreachedPassword fronteggSecurityRule `json:"breachedPassword"`
+	BotDetection     fronteggSecurityRule `json:"botDetection"`
+	SuspiciousIP     fronteggSecurityRule `json:"suspiciousIp"`
+	ImpossibleTravel fronteggSecurityRule `json:"impossibleTravel"`
+}
+
+func resourceFronteggSecurityRules() *schema.Resource {
+	rule := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description: description + " The rule is disabled when the block is omitted.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"enabled": {
+						Description: "Whether the rule is enforced.",
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     true,
+					},
+					"action": {
+						Description: `What to do when the rule is triggered.
+
+Must be one of "block", "mfa_challenge", or "notify".`,
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice([]string{"block", "mfa_challenge", "notify"}, false),
+					},
+				},
+			},
+		}
+	}
+	return &schema.Resource{
+		Description: `Configures the security rules for the workspace: breached-password detection, bot and suspicious-IP
+detection, and impossible-travel alerts.
+
+Lockout, password and captcha policies are configured on ` + "`frontegg_workspace`" + `.
+
+This is a singleton resource. You must only create one frontegg_security_rules resource
+per Frontegg provider.
+
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the security rules will remain in their last-applied state, unless ` + "`on_destroy = \"reset\"`" + ` is set.`,
+
+		CreateContext: resourceFronteggSecurityRulesCreate,
+		ReadContext:   resourceFronteggSecurityRulesRead,
+		UpdateContext: resourceFronteggSecurityRulesUpdate,
+		DeleteContext: resourceFronteggSecurityRulesDelete,
+		Importer:      onDestroyImporter(resourceFronteggSecurityRules),
+
+		Schema: map[string]*schema.Schema{
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
+			"breached_password":   rule("Checks passwords against known data breaches when users sign up, log in or change their password."),
+			"bot_detection":       rule("Detects automated sign-up and login attempts."),
+			"suspicious_ip":       rule("Detects logins from IP addresses with a bad reputation, such as anonymizing proxies."),
+			"impossible_travel":   rule("Detects consecutive logins from locations too far apart to travel between in the time elapsed."),
+		},
+	}
+}
+
+func serializeSecurityRuleAction(s string) string {
+	switch s {
+	case "block":
+		return "BLOCK"
+	case "mfa_challenge":
+		return "MFA"
+	case "notify":
+		return "NOTIFY"
+	}
+	return ""
+}
+
+func deserializeSecurityRuleAction(s string) string {
+	switch s {
+	case "BLOCK":
+		return "block"
+	case "MFA":
+		return "mfa_challenge"
+	case "NOTIFY":
+		return "notify"
+	}
+	return ""
+}
+
+func resourceFronteggSecurityRuleSerialize(d *schema.ResourceData, key string) fronteggSecurityRule {
+	blocks := d.Get(key).([]interface{})
+	if len(blocks) == 0 || blocks[0] == nil {
+		return fronteggSecurityRule{}
+	}
+	block := blocks[0].(map[string]interface{})
+	return fronteggSecurityRule{
+		Enabled: block["enabled"].(bool),
+		Action:  serializeSecurityRuleAction(block["action"].(string)),
+	}
+}
+
+// resourceFronteggSecurityRuleDeserialize sets the block for a rule. A disabled
+// rule is only kept when it is already configured, so omitting the block does
+// not produce a perpetual diff.
+func resourceFronteggSecurityRuleDeserialize(d *schema.ResourceData, key string, rule fronteggSecurityRule) error {
+	if !rule.Enabled && len(d.Get(key).([]interface{})) == 0 {
+		return d.Set(key, nil)
+	}
+	return d.Set(key, []interface{}{map[string]interface{}{
+		"enabled": rule.Enabled,
+		"action":  deserializeSecurityRuleAction(rule.Action),
+	}})
+}
+
+func resourceFronteggSecurityRulesSerialize(d *schema.ResourceData) fronteggSecurityRules {
+	return fronteggSecurityRules{
+		BreachedPassword: resourceFronteggSecurityRuleSerialize(d, "breached_password"),
+		BotDetection:     resourceFronteggSecurityRuleSerialize(d, "bot_detection"),
+		SuspiciousIP:     resourceFronteggSecurityRuleSerialize(d, "suspicious_ip"),
+		ImpossibleTravel: resourceFronteggSecurityRuleSerialize(d, "impossible_travel"),
+	}
+}
+
+func resourceFronteggSecurityRulesDeserialize(d *schema.ResourceData, f fronteggSecurityRules) error {
+	d.SetId(securityRulesID)
+	if err := resourceFronteggSecurityRuleDeserialize(d, "breached_password", f.BreachedPassword); err != nil {
+		return err
+	}
+	if err := resourceFronteggSecurityRuleDeserialize(d, "bot_detection", f.BotDetection); err != nil {
+		return err
+	}
+	if err := resourceFronteggSecurityRuleDeserialize(d, "suspicious_ip", f.SuspiciousIP); err != nil {
+		return err
+	}
+	if err := resourceFronteggSecurityRuleDeserialize(d, "impossible_travel", f.ImpossibleTravel); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceFronteggSecurityRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := captureOnDestroySnapshot(ctx, resourceFronteggSecurityRules(), d, meta); diags.HasError() {
+		return diags
+	}
+	return resourceFronteggSecurityRulesUpdate(ctx, d, meta)
+}
+
+func resourceFronteggSecurityRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := preserveOnDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
+	clientHolder := meta.(*restclient.ClientHolder)
+	var out fronteggSecurityRules
+	if err := clientHolder.ApiClient.Get(ctx, fronteggSecurityRulesURL, &out); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggSecurityRulesDeserialize(d, out); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggSecurityRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	in := resourceFronteggSecurityRulesSerialize(d)
+	if err := clientHolder.ApiClient.Post(ctx, fronteggSecurityRulesURL, in, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFronteggSecurityRulesRead(ctx, d, meta)
+}
+
+func resourceFronteggSecurityRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("on_destroy").(string) == onDestroyReset {
+		return resetFromOnDestroySnapshot(ctx, resourceFronteggSecurityRules(), d, meta)
+	}
+	log.Printf("[WARN] Cannot destroy security rules. Terraform will remove this resource from the " +
+		"state file, but the security rules will remain in their last-applied state.")
+	return nil
+}
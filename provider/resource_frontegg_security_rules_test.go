@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSecurityRulesSerializeRoundTrip(t *testing.T) {
+	r := resourceFronteggSecurityRules()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"breached_password": []interface{}{map[string]interface{}{"action": "mfa_challenge"}},
+		"impossible_travel": []interface{}{map[string]interface{}{"enabled": false, "action": "notify"}},
+	})
+
+	in := resourceFronteggSecurityRulesSerialize(d)
+	want := fronteggSecurityRules{
+		BreachedPassword: fronteggSecurityRule{Enabled: true, Action: "MFA"},
+		ImpossibleTravel: fronteggSecurityRule{Enabled: false, Action: "NOTIFY"},
+	}
+	if !reflect.DeepEqual(in, want) {
+		t.Fatalf("serialize = %+v, want %+v", in, want)
+	}
+
+	// Rules enabled outside of Terraform show up; disabled ones only when
+	// configured.
+	out := in
+	out.BotDetection = fronteggSecurityRule{Enabled: true, Action: "BLOCK"}
+	out.SuspiciousIP = fronteggSecurityRule{Enabled: false, Action: "NOTIFY"}
+	if err := resourceFronteggSecurityRulesDeserialize(d, out); err != nil {
+		t.Fatalf("deserialize: %v", err)
+	}
+	if got := d.Get("bot_detection.0.action"); got != "block" {
+		t.Errorf("bot_detection.0.action = %v, want block", got)
+	}
+	if got := d.Get("suspicious_ip").([]interface{}); len(got) != 0 {
+		t.Errorf("suspicious_ip = %v, want no block", got)
+	}
+	if got := d.Get("impossible_travel.0.enabled"); got != false {
+		t.Errorf("impossible_travel.0.enabled = %v, want false", got)
+	}
+	if got := d.Get("breached_password.0.action"); got != "mfa_challenge" {
+		t.Errorf("breached_password.0.action = %v, want mfa_challenge", got)
+	}
+}
@@ -44,17 +44,17 @@ These settings appear in the Frontegg portal under Configurations → Security 
 This is a singleton resource. You must only create one frontegg_session_management_policy resource
 per Frontegg provider.
 
-**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the session management policy will remain in its last-applied state.`,
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the session management policy will remain in its last-applied state, unless ` + "`on_destroy = \"reset\"`" + ` is set.`,
 
 		CreateContext: resourceFronteggSessionManagementPolicyCreate,
 		ReadContext:   resourceFronteggSessionManagementPolicyRead,
 		UpdateContext: resourceFronteggSessionManagementPolicyUpdate,
 		DeleteContext: resourceFronteggSessionManagementPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      onDestroyImporter(resourceFronteggSessionManagementPolicy),
 
 		Schema: map[string]*schema.Schema{
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
 			"idle_session_timeout_enabled": {
 				Description: "Whether the idle session timeout is enforced. When disabled, the platform default of 24 hours applies.",
 				Type:        schema.TypeBool,
@@ -148,10 +148,16 @@ func resourceFronteggSessionManagementPolicyDeserialize(d *schema.ResourceData,
 }
 
 func resourceFronteggSessionManagementPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := captureOnDestroySnapshot(ctx, resourceFronteggSessionManagementPolicy(), d, meta); diags.HasError() {
+		return diags
+	}
 	return resourceFronteggSessionManagementPolicyUpdate(ctx, d, meta)
 }
 
 func resourceFronteggSessionManagementPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := preserveOnDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	var out fronteggSessionManagementPolicy
 	if err := clientHolder.ApiClient.Get(ctx, fronteggSessionManagementPolicyReadURL, &out); err != nil {
@@ -173,6 +179,9 @@ func resourceFronteggSessionManagementPolicyUpdate(ctx context.Context, d *schem
 }
 
 func resourceFronteggSessionManagementPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("on_destroy").(string) == onDestroyReset {
+		return resetFromOnDestroySnapshot(ctx, resourceFronteggSessionManagementPolicy(), d, meta)
+	}
 	log.Printf("[WARN] Cannot destroy session management policy. Terraform will remove this resource from the " +
 		"state file, but the session management policy will remain in its last-applied state.")
 	return nil
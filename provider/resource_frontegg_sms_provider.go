@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/frontegg/terraform-provider-frontegg/provider/validators"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const fronteggSMSProviderPath = "/identity/resources/sms/v1/configurations"
+
+type fronteggSMSProvider struct {
+	Provider  string `json:"provider,omitempty"`
+	AccountID string `json:"accountId,omitempty"`
+	Secret    string `json:"secret,omitempty"`
+	Region    string `json:"region,omitempty"`
+	SenderID  string `json:"senderId,omitempty"`
+	CreatedAt string `json:"createdAt,omitempty"`
+	UpdatedAt string `json:"updatedAt,omitempty"`
+}
+
+func resourceFronteggSMSProvider() *schema.Resource {
+	return &schema.Resource{
+		Description: `Configures a Frontegg SMS provider, used to send one-time codes for the ` + "`SmsCode`" + ` auth strategy and SMS MFA.`,
+
+		CreateContext: resourceFronteggSMSProviderCreate,
+		ReadContext:   resourceFronteggSMSProviderRead,
+		UpdateContext: resourceFronteggSMSProviderUpdate,
+		DeleteContext: resourceFronteggSMSProviderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"provider_name": {
+				Description:  "Name of the SMS provider, one of `twilio`, `messagebird`, `vonage` or `sns` (If the provider is changed, the old provider's configuration will be deleted).",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validators.ValidateSMSProvider,
+			},
+			"account_id": {
+				Description: "The Twilio account SID, Vonage API key or Amazon SNS access key ID (required for Twilio, Vonage and Amazon SNS).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+			},
+			"secret": {
+				Description: "The Twilio auth token, MessageBird access key, Vonage API secret or Amazon SNS secret access key.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"region": {
+				Description: "The AWS region to send from (required only for Amazon SNS).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sender_id": {
+				Description: "The phone number or alphanumeric sender ID messages are sent from (required for Twilio, MessageBird and Vonage).",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"created_at": {
+				Description: "The timestamp at which the SMS provider was configured.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"updated_at": {
+				Description: "The timestamp at which the SMS provider was updated.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+		CustomizeDiff: validators.ValidateSMSRequiredFields,
+	}
+}
+
+func resourceFronteggSMSProviderSerialize(d *schema.ResourceData) fronteggSMSProvider {
+	return fronteggSMSProvider{
+		Provider:  d.Get("provider_name").(string),
+		AccountID: d.Get("account_id").(string),
+		Secret:    d.Get("secret").(string),
+		Region:    d.Get("region").(string),
+		SenderID:  d.Get("sender_id").(string),
+	}
+}
+
+func resourceFronteggSMSProviderDeserialize(d *schema.ResourceData, f fronteggSMSProvider) error {
+	fields := map[string]string{
+		"provider_name": f.Provider,
+		"account_id":    f.AccountID,
+		"region":        f.Region,
+		"sender_id":     f.SenderID,
+		"created_at":    f.CreatedAt,
+		"updated_at":    f.UpdatedAt,
+	}
+	// The secret is write-only; keep the one in state unless it is returned.
+	if f.Secret != "" {
+		fields["secret"] = f.Secret
+	}
+
+	for key, value := range fields {
+		if err := d.Set(key, value); err != nil {
+			return fmt.Errorf("error setting %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceFronteggSMSProviderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	in := resourceFronteggSMSProviderSerialize(d)
+	if err := clientHolder.ApiClient.Post(ctx, fronteggSMSProviderPath, in, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(in.Provider)
+	return resourceFronteggSMSProviderRead(ctx, d, meta)
+}
+
+func resourceFronteggSMSProviderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+
+	var out fronteggSMSProvider
+	if err := clientHolder.ApiClient.Get(ctx, fronteggSMSProviderPath, &out); err != nil {
+		if restclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	// If no SMS provider configuration exists, the response will be empty
+	if out.Provider == "" {
+		d.SetId("")
+		return nil
+	}
+
+	d.SetId(out.Provider)
+	if err := resourceFronteggSMSProviderDeserialize(d, out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceFronteggSMSProviderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	in := resourceFronteggSMSProviderSerialize(d)
+
+	if d.HasChange("provider_name") {
+		if err := clientHolder.ApiClient.Delete(ctx, fronteggSMSProviderPath, nil); err != nil && !restclient.IsNotFound(err) {
+			return diag.FromErr(err)
+		}
+	}
+
+	if err := clientHolder.ApiClient.Post(ctx, fronteggSMSProviderPath, in, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(in.Provider)
+	return resourceFronteggSMSProviderRead(ctx, d, meta)
+}
+
+func resourceFronteggSMSProviderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	if err := clientHolder.ApiClient.Delete(ctx, fronteggSMSProviderPath, nil); err != nil && !restclient.IsNotFound(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
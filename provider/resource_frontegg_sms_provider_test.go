@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/provider/validators"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSMSProviderRequiredFieldsAreOptional(t *testing.T) {
+	s := resourceFronteggSMSProvider().Schema
+	for provider, fields := range validators.SMSProviderRequiredFields {
+		if _, errs := validators.ValidateSMSProvider(provider, "provider_name"); len(errs) > 0 {
+			t.Errorf("%s: %v", provider, errs)
+		}
+		for _, field := range fields {
+			if f, ok := s[field]; !ok || !f.Optional {
+				t.Errorf("%s: %s must be an optional attribute of frontegg_sms_provider", provider, field)
+			}
+		}
+	}
+}
+
+func TestSMSProviderDeserializeKeepsSecret(t *testing.T) {
+	r := resourceFronteggSMSProvider()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"provider_name": "twilio",
+		"account_id":    "AC123",
+		"secret":        "auth-token",
+		"sender_id":     "+15550100",
+	})
+	out := fronteggSMSProvider{Provider: "twilio", AccountID: "AC123", SenderID: "+15550199"}
+	if err := resourceFronteggSMSProviderDeserialize(d, out); err != nil {
+		t.Fatal(err)
+	}
+	if got := d.Get("secret"); got != "auth-token" {
+		t.Errorf("secret = %v, want the configured secret", got)
+	}
+	if got := d.Get("sender_id"); got != "+15550199" {
+		t.Errorf("sender_id = %v", got)
+	}
+}
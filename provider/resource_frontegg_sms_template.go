@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const fronteggSMSTemplateURL = "/identity/resources/sms/v1/templates"
+
+type fronteggSMSTemplateLocalization struct {
+	Locale string `json:"locale"`
+	Body   string `json:"body"`
+}
+
+type fronteggSMSTemplate struct {
+	Type          string                            `json:"type"`
+	Active        bool                              `json:"active"`
+	SenderID      string                            `json:"senderId,omitempty"`
+	Body          string                            `json:"body"`
+	Localizations []fronteggSMSTemplateLocalization `json:"localizations"`
+}
+
+// fronteggSMSTemplatePlaceholders lists the placeholders each template type
+// can render, and fronteggSMSTemplateRequiredPlaceholders the ones a message
+// is useless without.
+var (
+	fronteggSMSTemplatePlaceholders = map[string][]string{
+		"OTC":            {"code", "expirationTime", "name"},
+		"MFAOTC":         {"code", "expirationTime", "name"},
+		"InviteToTenant": {"url", "tenantName", "inviterName", "name"},
+	}
+	fronteggSMSTemplateRequiredPlaceholders = map[string][]string{
+		"OTC":            {"code"},
+		"MFAOTC":         {"code"},
+		"InviteToTenant": {"url"},
+	}
+)
+
+var (
+	fronteggSMSPlaceholderRegexp = regexp.MustCompile(`\{\{\s*([^{}\s]*)\s*\}\}`)
+	fronteggLocaleRegexp         = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+)
+
+func resourceFronteggSMSTemplate() *schema.Resource {
+	return &schema.Resource{
+		Description: `Configures Frontegg SMS templates.
+
+Each SMS template resource manages one specific SMS template type for the workspace. Messages are sent through
+the provider configured with ` + "`frontegg_sms_provider`" + `.
+
+Placeholders are written as ` + "`{{name}}`" + `. Each template type only renders its own placeholders:
+
+* ` + "`OTC`" + ` and ` + "`MFAOTC`" + `: ` + "`code`" + ` (required), ` + "`expirationTime`" + `, ` + "`name`" + `.
+* ` + "`InviteToTenant`" + `: ` + "`url`" + ` (required), ` + "`tenantName`" + `, ` + "`inviterName`" + `, ` + "`name`" + `.
+
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the SMS template will remain in its last-applied state.`,
+
+		CreateContext: resourceFronteggSMSTemplateCreate,
+		ReadContext:   resourceFronteggSMSTemplateRead,
+		UpdateContext: resourceFronteggSMSTemplateUpdate,
+		DeleteContext: resourceFronteggSMSTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("template_type", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: resourceFronteggSMSTemplateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"template_type": {
+				Description: `The type of SMS template to configure.
+
+Must be one of: "OTC", "MFAOTC", "InviteToTenant".`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"OTC", "MFAOTC", "InviteToTenant"}, false),
+			},
+			"active": {
+				Description: "Whether the SMS template is active.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"sender_id": {
+				Description: "The phone number or alphanumeric sender ID to send from, overriding the one of the SMS provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"body": {
+				Description: "The message body, used for users whose locale has no entry in `localized_bodies`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"localized_bodies": {
+				Description: "Message bodies keyed by locale, such as `fr` or `pt-BR`.",
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// checkFronteggSMSTemplateBody reports the placeholders in body that
+// templateType cannot render, and the required ones body is missing.
+func checkFronteggSMSTemplateBody(templateType, body string) []string {
+	allowed := fronteggSMSTemplatePlaceholders[templateType]
+	used := map[string]bool{}
+	var problems []string
+	for _, match := range fronteggSMSPlaceholderRegexp.FindAllStringSubmatch(body, -1) {
+		name := match[1]
+		if used[name] {
+			continue
+		}
+		used[name] = true
+		if !stringInSlice(name, allowed) {
+			problems = append(problems, fmt.Sprintf("unknown placeholder {{%s}}", name))
+		}
+	}
+	for _, name := range fronteggSMSTemplateRequiredPlaceholders[templateType] {
+		if !used[name] {
+			problems = append(problems, fmt.Sprintf("missing placeholder {{%s}}", name))
+		}
+	}
+	return problems
+}
+
+// checkFronteggSMSTemplate validates the default body and every localized
+// body of a template.
+func checkFronteggSMSTemplate(templateType, body string, localized map[string]string) error {
+	var problems []string
+	for _, problem := range checkFronteggSMSTemplateBody(templateType, body) {
+		problems = append(problems, "body: "+problem)
+	}
+	locales := make([]string, 0, len(localized))
+	for locale := range localized {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	for _, locale := range locales {
+		if !fronteggLocaleRegexp.MatchString(locale) {
+			problems = append(problems, fmt.Sprintf("localized_bodies: %q is not a locale such as \"fr\" or \"pt-BR\"", locale))
+			continue
+		}
+		for _, problem := range checkFronteggSMSTemplateBody(templateType, localized[locale]) {
+			problems = append(problems, fmt.Sprintf("localized_bodies[%q]: %s", locale, problem))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	return fmt.Errorf("invalid %s SMS template (allowed placeholders: %s): %s",
+		templateType, strings.Join(fronteggSMSTemplatePlaceholders[templateType], ", "), strings.Join(problems, "; "))
+}
+
+func resourceFronteggSMSTemplateCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("template_type") || !d.NewValueKnown("body") || !d.NewValueKnown("localized_bodies") {
+		return nil
+	}
+	return checkFronteggSMSTemplate(
+		d.Get("template_type").(string),
+		d.Get("body").(string),
+		castResourceStringMap(d.Get("localized_bodies")),
+	)
+}
+
+func resourceFronteggSMSTemplateSerialize(d *schema.ResourceData) fronteggSMSTemplate {
+	localized := castResourceStringMap(d.Get("localized_bodies"))
+	localizations := make([]fronteggSMSTemplateLocalization, 0, len(localized))
+	for locale, body := range localized {
+		localizations = append(localizations, fronteggSMSTemplateLocalization{Locale: locale, Body: body})
+	}
+	sort.Slice(localizations, func(i, j int) bool { return localizations[i].Locale < localizations[j].Locale })
+	return fronteggSMSTemplate{
+		Type:          d.Get("template_type").(string),
+		Active:        d.Get("active").(bool),
+		SenderID:      d.Get("sender_id").(string),
+		Body:          d.Get("body").(string),
+		Localizations: localizations,
+	}
+}
+
+func resourceFronteggSMSTemplateDeserialize(d *schema.ResourceData, f fronteggSMSTemplate) error {
+	d.SetId(f.Type)
+	if err := d.Set("template_type", f.Type); err != nil {
+		return err
+	}
+	if err := d.Set("active", f.Active); err != nil {
+		return err
+	}
+	if err := d.Set("sender_id", f.SenderID); err != nil {
+		return err
+	}
+	if err := d.Set("body", f.Body); err != nil {
+		return err
+	}
+	localized := make(map[string]string, len(f.Localizations))
+	for _, localization := range f.Localizations {
+		localized[localization.Locale] = localization.Body
+	}
+	if err := d.Set("localized_bodies", localized); err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceFronteggSMSTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return resourceFronteggSMSTemplateUpdate(ctx, d, meta)
+}
+
+func resourceFronteggSMSTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	var out []fronteggSMSTemplate
+	if err := clientHolder.ApiClient.Get(ctx, fronteggSMSTemplateURL, &out); err != nil {
+		return diag.FromErr(err)
+	}
+
+	templateType := d.Get("template_type").(string)
+	for _, template := range out {
+		if template.Type == templateType {
+			if err := resourceFronteggSMSTemplateDeserialize(d, template); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+	}
+
+	// If template not found, it might be in default state
+	d.SetId("")
+	return nil
+}
+
+func resourceFronteggSMSTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	in := resourceFronteggSMSTemplateSerialize(d)
+
+	if err := clientHolder.ApiClient.Post(ctx, fronteggSMSTemplateURL, in, nil); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(in.Type)
+	return resourceFronteggSMSTemplateRead(ctx, d, meta)
+}
+
+func resourceFronteggSMSTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// SMS templates cannot be deleted, only reset to defaults
+	// We'll just remove from Terraform state
+	return nil
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheckFronteggSMSTemplate(t *testing.T) {
+	cases := []struct {
+		name         string
+		templateType string
+		body         string
+		localized    map[string]string
+		wantErr      []string
+	}{
+		{
+			name:         "valid code",
+			templateType: "OTC",
+			body:         "Your code is {{code}}. It expires in {{ expirationTime }}.",
+			localized:    map[string]string{"fr": "Votre code est {{code}}.", "pt-BR": "Seu código é {{code}}."},
+		},
+		{
+			name:         "valid invite",
+			templateType: "InviteToTenant",
+			body:         "{{inviterName}} invited you to {{tenantName}}: {{url}}",
+		},
+		{
+			name:         "placeholder of another type",
+			templateType: "MFAOTC",
+			body:         "Your code is {{code}}, join {{tenantName}}",
+			wantErr:      []string{"body: unknown placeholder {{tenantName}}"},
+		},
+		{
+			name:         "missing required placeholder in a locale",
+			templateType: "OTC",
+			body:         "Your code is {{code}}",
+			localized:    map[string]string{"de": "Ihr Code"},
+			wantErr:      []string{`localized_bodies["de"]: missing placeholder {{code}}`},
+		},
+		{
+			name:         "invalid locale",
+			templateType: "OTC",
+			body:         "{{code}}",
+			localized:    map[string]string{"French": "{{code}}"},
+			wantErr:      []string{`"French" is not a locale`},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkFronteggSMSTemplate(c.templateType, c.body, c.localized)
+			if len(c.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("expected an error containing %v", c.wantErr)
+			}
+			for _, want := range c.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not contain %q", err, want)
+				}
+			}
+		})
+	}
+}
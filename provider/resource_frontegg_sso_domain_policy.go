@@ -16,17 +16,17 @@ func resourceFronteggSSODomainPolicy() *schema.Resource {
 This is a singleton resource. You must only create one frontegg_sso_domain_policy resource
 per Frontegg provider.
 
-**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the SSO domain policy will remain in its last-applied state.`,
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the SSO domain policy will remain in its last-applied state, unless ` + "`on_destroy = \"reset\"`" + ` is set.`,
 
 		CreateContext: resourceFronteggSSODomainPolicyCreate,
 		ReadContext:   resourceFronteggSSODomainPolicyRead,
 		UpdateContext: resourceFronteggSSODomainPolicyUpdate,
 		DeleteContext: resourceFronteggSSODomainPolicyDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
-		},
+		Importer:      onDestroyImporter(resourceFronteggSSODomainPolicy),
 
 		Schema: map[string]*schema.Schema{
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
 			"allow_verified_users_to_add_domains": {
 				Description: "Whether to allow users to add their own email domain without validating the domain through DNS.",
 				Type:        schema.TypeBool,
@@ -71,10 +71,16 @@ func resourceFronteggSSODomainPolicyDeserialize(d *schema.ResourceData, f fronte
 }
 
 func resourceFronteggSSODomainPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := captureOnDestroySnapshot(ctx, resourceFronteggSSODomainPolicy(), d, meta); diags.HasError() {
+		return diags
+	}
 	return resourceFronteggSSODomainPolicyUpdate(ctx, d, meta)
 }
 
 func resourceFronteggSSODomainPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := preserveOnDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	var out fronteggSSODomain
 	clientHolder.ApiClient.Ignore404()
@@ -97,6 +103,9 @@ func resourceFronteggSSODomainPolicyUpdate(ctx context.Context, d *schema.Resour
 }
 
 func resourceFronteggSSODomainPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("on_destroy").(string) == onDestroyReset {
+		return resetFromOnDestroySnapshot(ctx, resourceFronteggSSODomainPolicy(), d, meta)
+	}
 	log.Printf("[WARN] Cannot destroy SSO domain policy. Terraform will remove this resource from the " +
 		"state file, but the SSO domain policy will remain in its last-applied state.")
 	return nil
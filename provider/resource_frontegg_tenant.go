@@ -11,17 +11,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
-const fronteggTenantPath = "/tenants/resources/tenants/v1"
+const (
+	fronteggTenantPath   = "/tenants/resources/tenants/v1"
+	fronteggTenantPathV2 = "/tenants/resources/tenants/v2"
+	// Sub-tenants are created through their own endpoint and moved between
+	// parents through the hierarchy endpoints.
+	fronteggSubTenantPath       = "/tenants/resources/sub-tenants/v1"
+	fronteggTenantHierarchyPath = "/tenants/resources/hierarchy/v1"
+)
 
 type fronteggTenant struct {
 	Key            string `json:"tenantId,omitempty"`
 	Name           string `json:"name,omitempty"`
 	ApplicationUri string `json:"applicationUrl,omitempty"`
+	// ParentTenantID is nil when the response does not carry the parent, in
+	// which case the one in state is kept.
+	ParentTenantID *string `json:"parentTenantId,omitempty"`
 	// `Metadata` is only populated when deserializing an API response from JSON,
 	// since metadata is returned as a jsonified-string.
 	Metadata string `json:"metadata,omitempty"`
 }
 
+type fronteggTenantParent struct {
+	ParentTenantID string `json:"parentTenantId"`
+}
+
 type fronteggTenantMetadata struct {
 	Metadata map[string]string `json:"metadata,omitempty"`
 }
@@ -37,6 +51,7 @@ func resourceFronteggTenant() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: resourceFronteggTenantCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -50,6 +65,16 @@ func resourceFronteggTenant() *schema.Resource {
 				Required:    true,
 				ForceNew:    true,
 			},
+			"parent_tenant_id": {
+				Description: `The key of the parent tenant, making this tenant a sub-tenant of it. Changing the parent moves
+the tenant in the hierarchy, while setting a parent on a root tenant recreates it. If unset, the tenant keeps the
+parent it has in Frontegg. Combine with ` + "`sub_account_access_limit`" + ` on the SSO configurations to let users of
+the parent reach its sub-accounts.`,
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"deletion_protection": deletionProtectionSchema(),
 			"application_uri": {
 				Description: "The application URI for this tenant.",
 				Type:        schema.TypeString,
@@ -84,9 +109,17 @@ func resourceFronteggTenantDeserialize(d *schema.ResourceData, f fronteggTenant)
 	if err := d.Set("key", f.Key); err != nil {
 		return err
 	}
+	if err := preserveDeletionProtection(d); err != nil {
+		return err
+	}
 	if err := d.Set("application_uri", f.ApplicationUri); err != nil {
 		return err
 	}
+	if f.ParentTenantID != nil {
+		if err := d.Set("parent_tenant_id", *f.ParentTenantID); err != nil {
+			return err
+		}
+	}
 
 	if len(f.Metadata) > 0 {
 		if err := resourceFronteggTenantMetadataDeserialize(d, f.Metadata); err != nil {
@@ -122,17 +155,45 @@ func resourceFronteggTenantMetadataDeserialize(d *schema.ResourceData, metadata
 	return nil
 }
 
+// tenantParentChangeRequiresReplacement reports whether changing the parent
+// from before to after turns a root tenant into a sub-tenant or the reverse,
+// which the hierarchy endpoints cannot do in place. Since parent_tenant_id is
+// computed when unset, only configuring a parent on a root tenant reaches this
+// with a change.
+func tenantParentChangeRequiresReplacement(before, after string) bool {
+	return (before == "") != (after == "")
+}
+
+func resourceFronteggTenantCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	forceNew := []string{"key"}
+	if d.Id() != "" && d.HasChange("parent_tenant_id") && d.NewValueKnown("parent_tenant_id") {
+		before, after := d.GetChange("parent_tenant_id")
+		if tenantParentChangeRequiresReplacement(before.(string), after.(string)) {
+			if err := d.ForceNew("parent_tenant_id"); err != nil {
+				return err
+			}
+			forceNew = append(forceNew, "parent_tenant_id")
+		}
+	}
+	return deletionProtectionCustomizeDiff("frontegg_tenant", forceNew...)(ctx, d, meta)
+}
+
 func resourceFronteggTenantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
 	in := resourceFronteggTenantSerialize(d)
+	path := fronteggTenantPath
+	if parentTenantID := d.Get("parent_tenant_id").(string); parentTenantID != "" {
+		in.ParentTenantID = &parentTenantID
+		path = fronteggSubTenantPath
+	}
 	var out fronteggTenant
-	if err := clientHolder.ApiClient.Post(ctx, fronteggTenantPath, in, &out); err != nil {
+	if err := clientHolder.ApiClient.Post(ctx, path, in, &out); err != nil {
 		// Check if the error is because tenant already exists
 		if strings.Contains(err.Error(), "Tenant already exists") {
 			// Find the existing tenant using the specific API endpoint
 			tenantKey := d.Get("key").(string)
 			var existingTenant fronteggTenant
-			if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("/tenants/resources/tenants/v2/%s", tenantKey), &existingTenant); err != nil {
+			if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/%s", fronteggTenantPathV2, tenantKey), &existingTenant); err != nil {
 				return diag.FromErr(err)
 			}
 
@@ -142,6 +203,10 @@ func resourceFronteggTenantCreate(ctx context.Context, d *schema.ResourceData, m
 		}
 		return diag.FromErr(err)
 	}
+	// Write responses don't always echo the parent, so keep the one we set.
+	if out.ParentTenantID != nil && *out.ParentTenantID == "" {
+		out.ParentTenantID = nil
+	}
 	if err := resourceFronteggTenantDeserialize(d, out); err != nil {
 		return diag.FromErr(err)
 	}
@@ -204,11 +269,24 @@ func resourceFronteggTenantUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// Parent: only moves between parents reach here, see
+	// resourceFronteggTenantCustomizeDiff.
+	if d.HasChange("parent_tenant_id") {
+		in := fronteggTenantParent{ParentTenantID: d.Get("parent_tenant_id").(string)}
+		if err := clientHolder.ApiClient.Put(ctx, fmt.Sprintf("%s/%s/parent", fronteggTenantHierarchyPath, d.Id()), in, nil); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	var out fronteggTenant
 	in := resourceFronteggTenantSerialize(d)
 	if err := clientHolder.ApiClient.Put(ctx, fmt.Sprintf("%s/%s", fronteggTenantPath, d.Id()), in, &out); err != nil {
 		return diag.FromErr(err)
 	}
+	// Write responses don't always echo the parent, so keep the one we set.
+	if out.ParentTenantID != nil && *out.ParentTenantID == "" {
+		out.ParentTenantID = nil
+	}
 	if err := resourceFronteggTenantDeserialize(d, out); err != nil {
 		return diag.FromErr(err)
 	}
@@ -216,6 +294,9 @@ func resourceFronteggTenantUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func resourceFronteggTenantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(d, "frontegg_tenant"); diags.HasError() {
+		return diags
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	if err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggTenantPath, d.Id()), nil); err != nil {
 		return diag.FromErr(err)
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
 	"github.com/frontegg/terraform-provider-frontegg/provider/validators"
@@ -54,7 +53,7 @@ The token ` + "`secret`" + ` is returned only at creation time and is never retr
 
 ` + "`tenant_id`" + ` and ` + "`expires_in_minutes`" + ` are immutable and force replacement when changed. ` + "`description`" + `, ` + "`role_ids`" + `, and ` + "`metadata`" + ` can be updated in-place.
 
-**Import note:** After import, the ` + "`secret`" + ` and ` + "`metadata`" + ` fields will be empty in state. Import format: ` + "`tenant_id:client_id`" + `.`,
+**Import note:** After import, the ` + "`secret`" + ` and ` + "`metadata`" + ` fields will be empty in state. Import with an ` + "`identity`" + ` of ` + "`tenant_id`" + ` and ` + "`client_id`" + `, or with the ID format ` + "`tenant_id:client_id`" + `.`,
 
 		CreateContext: resourceFronteggTenantAPITokenCreate,
 		ReadContext:   resourceFronteggTenantAPITokenRead,
@@ -64,6 +63,14 @@ The token ` + "`secret`" + ` is returned only at creation time and is never retr
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceFronteggTenantAPITokenImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id": requiredIdentityAttribute("The ID of the tenant that owns the API token."),
+					"client_id": requiredIdentityAttribute("The client ID of the API token."),
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
@@ -122,14 +129,14 @@ The token ` + "`secret`" + ` is returned only at creation time and is never retr
 }
 
 func resourceFronteggTenantAPITokenImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("invalid import format, expected tenant_id:client_id, got: %s", d.Id())
+	ids, err := importIdentity(d, ":", "tenant_id", "client_id")
+	if err != nil {
+		return nil, err
 	}
-	if err := d.Set("tenant_id", parts[0]); err != nil {
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
 		return nil, err
 	}
-	d.SetId(parts[1])
+	d.SetId(ids["client_id"])
 	return []*schema.ResourceData{d}, nil
 }
 
@@ -226,6 +233,12 @@ func resourceFronteggTenantAPITokenRead(ctx context.Context, d *schema.ResourceD
 	if err := d.Set("created_at", found.CreatedAt); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := setIdentity(d, map[string]interface{}{
+		"tenant_id": tenantID,
+		"client_id": found.ClientID,
+	}); err != nil {
+		return diag.FromErr(err)
+	}
 	// Do not set "secret" — not returned by the read API; preserved from creation state.
 	// Do not set "metadata" — write-only; not returned by the read API.
 	return nil
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	fronteggDomainRestrictionsURL       = "/identity/resources/configurations/restrictions/v1/email-domain"
+	fronteggDomainRestrictionsConfigURL = fronteggDomainRestrictionsURL + "/config"
+)
+
+const (
+	fronteggDomainRestrictionAllow = "ALLOW"
+	fronteggDomainRestrictionBlock = "BLOCK"
+)
+
+type fronteggDomainRestrictionsConfig struct {
+	Active bool `json:"active"`
+}
+
+type fronteggDomainRestriction struct {
+	ID     string `json:"id,omitempty"`
+	Domain string `json:"domain"`
+	Type   string `json:"type"`
+}
+
+type fronteggDomainRestrictionList struct {
+	Items []fronteggDomainRestriction `json:"items"`
+}
+
+var fronteggEmailDomainRegexp = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}$`)
+
+func resourceFronteggTenantDomainRestrictions() *schema.Resource {
+	domainSet := func(description string) *schema.Schema {
+		return &schema.Schema{
+			Description: description,
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringMatch(fronteggEmailDomainRegexp, "must be a domain name such as example.com"),
+			},
+		}
+	}
+	return &schema.Resource{
+		Description: `Configures which email domains may be invited to or sign up into a Frontegg tenant.
+
+This is a singleton resource per tenant. You must only create one frontegg_tenant_domain_restrictions resource
+per tenant. It complements ` + "`frontegg_tenant_sso_domain`" + `, which routes domains to an SSO configuration;
+blocking, or leaving off the allow list, a domain routed to SSO fails. The check runs against live state while
+planning and again right before the restrictions are written, so an SSO domain created in the same apply is only
+caught at apply time.
+
+**Note:** When destroyed, the tenant's domain restrictions are removed and disabled.`,
+
+		CreateContext: resourceFronteggTenantDomainRestrictionsCreate,
+		ReadContext:   resourceFronteggTenantDomainRestrictionsRead,
+		UpdateContext: resourceFronteggTenantDomainRestrictionsUpdate,
+		DeleteContext: resourceFronteggTenantDomainRestrictionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("tenant_id", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: resourceFronteggTenantDomainRestrictionsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant for which to configure domain restrictions.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"allowed_domains": domainSet("If set, only users with email addresses in these domains may be invited to or sign up into the tenant."),
+			"blocked_domains": domainSet("Users with email addresses in these domains may not be invited to or sign up into the tenant."),
+		},
+	}
+}
+
+func resourceFronteggTenantDomainRestrictionsHeaders(d *schema.ResourceData) (http.Header, error) {
+	tenantID := d.Get("tenant_id").(string)
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant_id is required but is empty")
+	}
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	return headers, nil
+}
+
+// fronteggDomainRestrictionConflicts returns the conflicts between the allow
+// and block lists, and between them and the domains routed to SSO. Domains are
+// compared case-insensitively.
+func fronteggDomainRestrictionConflicts(allowed, blocked, ssoDomains []string) []string {
+	normalize := func(domains []string) map[string]bool {
+		out := map[string]bool{}
+		for _, domain := range domains {
+			out[strings.ToLower(domain)] = true
+		}
+		return out
+	}
+	allowedSet, blockedSet := normalize(allowed), normalize(blocked)
+
+	var conflicts []string
+	for domain := range allowedSet {
+		if blockedSet[domain] {
+			conflicts = append(conflicts, fmt.Sprintf("%q is both allowed and blocked", domain))
+		}
+	}
+	for domain := range normalize(ssoDomains) {
+		if blockedSet[domain] {
+			conflicts = append(conflicts, fmt.Sprintf("%q is routed to SSO but blocked", domain))
+		} else if len(allowedSet) > 0 && !allowedSet[domain] {
+			conflicts = append(conflicts, fmt.Sprintf("%q is routed to SSO but not allowed", domain))
+		}
+	}
+	sort.Strings(conflicts)
+	return conflicts
+}
+
+// fetchFronteggTenantSSODomains returns every domain routed to one of the
+// tenant's SSO configurations.
+func fetchFronteggTenantSSODomains(ctx context.Context, client *restclient.Client, headers http.Header) ([]string, error) {
+	var configs []fronteggTenantSSOConfigWithDomains
+	if err := client.GetWithHeaders(ctx, fronteggTenantSSOConfigPath, headers, &configs); err != nil {
+		return nil, err
+	}
+	var domains []string
+	for _, config := range configs {
+		for _, domain := range config.Domains {
+			domains = append(domains, domain.Domain)
+		}
+	}
+	return domains, nil
+}
+
+// fetchFronteggDomainRestrictions returns the tenant's allowed and blocked
+// domains, both empty if restrictions are disabled.
+func fetchFronteggDomainRestrictions(ctx context.Context, client *restclient.Client, headers http.Header) (allowed, blocked []string, err error) {
+	var config fronteggDomainRestrictionsConfig
+	if err := client.GetWithHeaders(ctx, fronteggDomainRestrictionsConfigURL, headers, &config); err != nil {
+		return nil, nil, err
+	}
+	if !config.Active {
+		return nil, nil, nil
+	}
+	entries, err := listFronteggDomainRestrictions(ctx, client, headers)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		switch entry.Type {
+		case fronteggDomainRestrictionAllow:
+			allowed = append(allowed, entry.Domain)
+		case fronteggDomainRestrictionBlock:
+			blocked = append(blocked, entry.Domain)
+		}
+	}
+	sort.Strings(allowed)
+	sort.Strings(blocked)
+	return allowed, blocked, nil
+}
+
+func listFronteggDomainRestrictions(ctx context.Context, client *restclient.Client, headers http.Header) ([]fronteggDomainRestriction, error) {
+	var out fronteggDomainRestrictionList
+	if err := client.GetWithHeaders(ctx, fronteggDomainRestrictionsURL, headers, &out); err != nil {
+		return nil, err
+	}
+	return out.Items, nil
+}
+
+func resourceFronteggTenantDomainRestrictionsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("allowed_domains", "blocked_domains") || !d.NewValueKnown("allowed_domains") || !d.NewValueKnown("blocked_domains") {
+		return nil
+	}
+	allowed := stringSetToList(d.Get("allowed_domains").(*schema.Set))
+	blocked := stringSetToList(d.Get("blocked_domains").(*schema.Set))
+
+	clientHolder, ok := meta.(*restclient.ClientHolder)
+	if tenantID := d.Get("tenant_id").(string); ok && d.NewValueKnown("tenant_id") && tenantID != "" {
+		return checkFronteggDomainRestrictionsConflicts(ctx, clientHolder, tenantID, allowed, blocked)
+	}
+	if conflicts := fronteggDomainRestrictionConflicts(allowed, blocked, nil); len(conflicts) > 0 {
+		return fmt.Errorf("domain restrictions conflict: %s", strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// checkFronteggDomainRestrictionsConflicts fails if allowed and blocked
+// conflict with each other or with the tenant's live SSO domains. It runs
+// while planning and again right before writing, since an SSO domain created
+// earlier in the same apply is not visible to the plan.
+func checkFronteggDomainRestrictionsConflicts(ctx context.Context, clientHolder *restclient.ClientHolder, tenantID string, allowed, blocked []string) error {
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	ssoDomains, err := fetchFronteggTenantSSODomains(ctx, &clientHolder.ApiClient, headers)
+	recordFronteggDomainConflictCheck(clientHolder, "frontegg_tenant_domain_restrictions", tenantID, "SSO domains", err)
+	if conflicts := fronteggDomainRestrictionConflicts(allowed, blocked, ssoDomains); len(conflicts) > 0 {
+		return fmt.Errorf("domain restrictions conflict: %s", strings.Join(conflicts, ", "))
+	}
+	return nil
+}
+
+// fronteggDomainConflictCheckKey keys, in ClientHolder.Cache, why the
+// plan-time conflict check of a resource type was skipped for a tenant.
+type fronteggDomainConflictCheckKey struct {
+	resource string
+	tenantID string
+}
+
+// recordFronteggDomainConflictCheck remembers that the conflict check of
+// resource was skipped for tenantID because fetching what it checks against
+// failed with err, or forgets it if err is nil. CustomizeDiff cannot return
+// warnings, so Create and Update report it through
+// fronteggDomainConflictCheckWarning.
+func recordFronteggDomainConflictCheck(clientHolder *restclient.ClientHolder, resource, tenantID, against string, err error) {
+	key := fronteggDomainConflictCheckKey{resource: resource, tenantID: tenantID}
+	if err == nil {
+		clientHolder.Cache.Delete(key)
+		return
+	}
+	log.Printf("[WARN] Skipping %s conflict detection for tenant %s: %s", against, tenantID, err)
+	clientHolder.Cache.Store(key, fmt.Errorf("fetching %s: %w", against, err))
+}
+
+func fronteggDomainConflictCheckWarning(clientHolder *restclient.ClientHolder, resource, tenantID string) diag.Diagnostics {
+	value, ok := clientHolder.Cache.Load(fronteggDomainConflictCheckKey{resource: resource, tenantID: tenantID})
+	if !ok {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Skipped domain conflict detection",
+		Detail:   fmt.Sprintf("Conflicts between the SSO domains and the domain restrictions of tenant %s were not checked: %s", tenantID, value.(error)),
+	}}
+}
+
+func resourceFronteggTenantDomainRestrictionsEntries(d *schema.ResourceData) []fronteggDomainRestriction {
+	var entries []fronteggDomainRestriction
+	for _, domain := range stringSetToList(d.Get("allowed_domains").(*schema.Set)) {
+		entries = append(entries, fronteggDomainRestriction{Domain: domain, Type: fronteggDomainRestrictionAllow})
+	}
+	for _, domain := range stringSetToList(d.Get("blocked_domains").(*schema.Set)) {
+		entries = append(entries, fronteggDomainRestriction{Domain: domain, Type: fronteggDomainRestrictionBlock})
+	}
+	return entries
+}
+
+// syncFronteggDomainRestrictions makes the tenant's entries match desired and
+// enables restrictions if there are any.
+func syncFronteggDomainRestrictions(ctx context.Context, client *restclient.Client, headers http.Header, desired []fronteggDomainRestriction) error {
+	current, err := listFronteggDomainRestrictions(ctx, client, headers)
+	if err != nil {
+		return err
+	}
+	key := func(r fronteggDomainRestriction) string { return r.Type + ":" + strings.ToLower(r.Domain) }
+	have := map[string]bool{}
+	for _, r := range current {
+		have[key(r)] = true
+	}
+	want := map[string]bool{}
+	for _, r := range desired {
+		want[key(r)] = true
+	}
+	for _, r := range current {
+		if want[key(r)] {
+			continue
+		}
+		if err := client.DeleteWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggDomainRestrictionsURL, r.ID), headers, nil); err != nil && !restclient.IsNotFound(err) {
+			return err
+		}
+	}
+	for _, r := range desired {
+		if have[key(r)] {
+			continue
+		}
+		if err := client.PostWithHeaders(ctx, fronteggDomainRestrictionsURL, headers, r, nil); err != nil {
+			return err
+		}
+	}
+	config := fronteggDomainRestrictionsConfig{Active: len(desired) > 0}
+	return client.PostWithHeaders(ctx, fronteggDomainRestrictionsConfigURL, headers, config, nil)
+}
+
+func resourceFronteggTenantDomainRestrictionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantDomainRestrictionsHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowed := stringSetToList(d.Get("allowed_domains").(*schema.Set))
+	blocked := stringSetToList(d.Get("blocked_domains").(*schema.Set))
+	if err := checkFronteggDomainRestrictionsConflicts(ctx, clientHolder, d.Get("tenant_id").(string), allowed, blocked); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncFronteggDomainRestrictions(ctx, &clientHolder.ApiClient, headers, resourceFronteggTenantDomainRestrictionsEntries(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("tenant_id").(string))
+	diags := resourceFronteggTenantDomainRestrictionsRead(ctx, d, meta)
+	return append(diags, fronteggDomainConflictCheckWarning(clientHolder, "frontegg_tenant_domain_restrictions", d.Get("tenant_id").(string))...)
+}
+
+func resourceFronteggTenantDomainRestrictionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantDomainRestrictionsHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowed, blocked, err := fetchFronteggDomainRestrictions(ctx, &clientHolder.ApiClient, headers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("allowed_domains", allowed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("blocked_domains", blocked); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggTenantDomainRestrictionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantDomainRestrictionsHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	allowed := stringSetToList(d.Get("allowed_domains").(*schema.Set))
+	blocked := stringSetToList(d.Get("blocked_domains").(*schema.Set))
+	if err := checkFronteggDomainRestrictionsConflicts(ctx, clientHolder, d.Get("tenant_id").(string), allowed, blocked); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncFronteggDomainRestrictions(ctx, &clientHolder.ApiClient, headers, resourceFronteggTenantDomainRestrictionsEntries(d)); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := resourceFronteggTenantDomainRestrictionsRead(ctx, d, meta)
+	return append(diags, fronteggDomainConflictCheckWarning(clientHolder, "frontegg_tenant_domain_restrictions", d.Get("tenant_id").(string))...)
+}
+
+func resourceFronteggTenantDomainRestrictionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantDomainRestrictionsHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncFronteggDomainRestrictions(ctx, &clientHolder.ApiClient, headers, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFronteggDomainRestrictionConflicts(t *testing.T) {
+	cases := []struct {
+		name                         string
+		allowed, blocked, ssoDomains []string
+		want                         []string
+	}{
+		{
+			name:       "no restrictions",
+			ssoDomains: []string{"example.com"},
+		},
+		{
+			name:       "sso domain allowed",
+			allowed:    []string{"Example.com", "partner.io"},
+			ssoDomains: []string{"example.com"},
+		},
+		{
+			name:       "sso domain blocked",
+			blocked:    []string{"example.com"},
+			ssoDomains: []string{"EXAMPLE.com"},
+			want:       []string{`"example.com" is routed to SSO but blocked`},
+		},
+		{
+			name:       "sso domain missing from allow list",
+			allowed:    []string{"partner.io"},
+			ssoDomains: []string{"example.com"},
+			want:       []string{`"example.com" is routed to SSO but not allowed`},
+		},
+		{
+			name:    "allowed and blocked",
+			allowed: []string{"example.com"},
+			blocked: []string{"example.com", "spam.io"},
+			want:    []string{`"example.com" is both allowed and blocked`},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := fronteggDomainRestrictionConflicts(c.allowed, c.blocked, c.ssoDomains); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("fronteggDomainRestrictionConflicts() = %v, want %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestFronteggDomainConflictCheckWarning(t *testing.T) {
+	holder := &restclient.ClientHolder{}
+	const resource = "frontegg_tenant_domain_restrictions"
+
+	if diags := fronteggDomainConflictCheckWarning(holder, resource, "tenant-1"); len(diags) != 0 {
+		t.Errorf("expected no warning before any check, got %v", diags)
+	}
+
+	recordFronteggDomainConflictCheck(holder, resource, "tenant-1", "SSO domains", errors.New("boom"))
+	diags := fronteggDomainConflictCheckWarning(holder, resource, "tenant-1")
+	if len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Errorf("expected a warning after a skipped check, got %v", diags)
+	}
+	if diags := fronteggDomainConflictCheckWarning(holder, resource, "tenant-2"); len(diags) != 0 {
+		t.Errorf("expected no warning for another tenant, got %v", diags)
+	}
+
+	recordFronteggDomainConflictCheck(holder, resource, "tenant-1", "SSO domains", nil)
+	if diags := fronteggDomainConflictCheckWarning(holder, resource, "tenant-1"); len(diags) != 0 {
+		t.Errorf("expected no warning after a successful check, got %v", diags)
+	}
+}
+
+func TestTenantSSODomainCreateRechecksLiveRestrictions(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == fronteggDomainRestrictionsConfigURL:
+			_ = json.NewEncoder(w).Encode(fronteggDomainRestrictionsConfig{Active: true})
+		case r.Method == http.MethodGet && r.URL.Path == fronteggDomainRestrictionsURL:
+			// Written earlier in the same apply, after the plan ran.
+			_ = json.NewEncoder(w).Encode(fronteggDomainRestrictionList{Items: []fronteggDomainRestriction{
+				{ID: "r1", Domain: "Example.com", Type: fronteggDomainRestrictionBlock},
+			}})
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+
+	r := resourceFronteggTenantSSODomain()
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), nil)
+	_ = d.Set("tenant_id", "tenant-1")
+	_ = d.Set("sso_config_id", "sso-1")
+	_ = d.Set("domain", "example.com")
+	if diags := resourceFronteggTenantSSODomainCreate(context.Background(), d, holder); !diags.HasError() {
+		t.Error("expected a blocked domain to fail before it is created")
+	}
+	if d.Id() != "" {
+		t.Errorf("expected no domain to be created, got id %q", d.Id())
+	}
+}
@@ -0,0 +1,327 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	fronteggIPRestrictionsURL       = "/identity/resources/configurations/restrictions/v1/ip"
+	fronteggIPRestrictionsConfigURL = fronteggIPRestrictionsURL + "/config"
+)
+
+type fronteggIPRestrictionsConfig struct {
+	IsActive bool   `json:"isActive"`
+	Strategy string `json:"strategy"`
+}
+
+type fronteggIPRestriction struct {
+	ID          string `json:"id,omitempty"`
+	IP          string `json:"ip"`
+	Description string `json:"description,omitempty"`
+	Strategy    string `json:"strategy"`
+	IsActive    bool   `json:"isActive"`
+}
+
+type fronteggIPRestrictionList struct {
+	Items []fronteggIPRestriction `json:"items"`
+}
+
+func resourceFronteggTenantIPRestrictions() *schema.Resource {
+	return &schema.Resource{
+		Description: `Configures the IP restrictions for a Frontegg tenant, limiting the networks its users may sign in from.
+
+This is a singleton resource per tenant. You must only create one frontegg_tenant_ip_restrictions resource
+per tenant. The resource manages every IP restriction entry of the tenant, whatever its strategy; entries
+created outside of Terraform show up in the plan and are removed on the next apply.
+
+**Note:** When destroyed, the tenant's IP restriction entries are removed and IP restrictions are disabled.`,
+
+		CreateContext: resourceFronteggTenantIPRestrictionsCreate,
+		ReadContext:   resourceFronteggTenantIPRestrictionsRead,
+		UpdateContext: resourceFronteggTenantIPRestrictionsUpdate,
+		DeleteContext: resourceFronteggTenantIPRestrictionsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: func(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+				if err := d.Set("tenant_id", d.Id()); err != nil {
+					return nil, err
+				}
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: resourceFronteggTenantIPRestrictionsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant for which to configure IP restrictions.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"enabled": {
+				Description: "Whether IP restrictions are enforced for the tenant.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"mode": {
+				Description: `Whether the listed networks are the only ones allowed, or the ones blocked.
+
+Must be one of "allow" or "block".`,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"allow", "block"}, false),
+			},
+			"cidr": {
+				Description: "A network to allow or block.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cidr": {
+							Description:  "The network in CIDR notation, such as `203.0.113.0/24`, or a single IP address.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateFronteggCIDR,
+						},
+						"description": {
+							Description: "A human-readable description of the network.",
+							Type:        schema.TypeString,
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateFronteggCIDR accepts a single IP address or a CIDR whose address is
+// the network address, so that what is planned is what Frontegg enforces.
+func validateFronteggCIDR(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	if net.ParseIP(v) != nil {
+		return nil, nil
+	}
+	ip, network, err := net.ParseCIDR(v)
+	if err != nil {
+		return nil, []error{fmt.Errorf("expected %s to be an IP address or a CIDR, got %q", k, v)}
+	}
+	if !ip.Equal(network.IP) {
+		return nil, []error{fmt.Errorf("expected %s to be a network address, got %q; did you mean %q?", k, v, network.String())}
+	}
+	return nil, nil
+}
+
+// checkFronteggIPRestrictions returns the plan-time problems with a set of
+// restriction entries.
+func checkFronteggIPRestrictions(enabled bool, mode string, entries []fronteggIPRestriction) error {
+	if enabled && mode == "allow" && len(entries) == 0 {
+		return fmt.Errorf("an enabled allow list without any cidr blocks would lock every user out of the tenant")
+	}
+	seen := map[string]bool{}
+	for _, entry := range entries {
+		if seen[entry.IP] {
+			return fmt.Errorf("cidr %q is listed more than once", entry.IP)
+		}
+		seen[entry.IP] = true
+	}
+	return nil
+}
+
+func resourceFronteggTenantIPRestrictionsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("cidr") || !d.NewValueKnown("enabled") || !d.NewValueKnown("mode") {
+		return nil
+	}
+	return checkFronteggIPRestrictions(d.Get("enabled").(bool), d.Get("mode").(string), resourceFronteggTenantIPRestrictionsEntries(d))
+}
+
+func serializeIPRestrictionStrategy(mode string) string {
+	return strings.ToUpper(mode)
+}
+
+func deserializeIPRestrictionStrategy(strategy string) string {
+	return strings.ToLower(strategy)
+}
+
+func resourceFronteggTenantIPRestrictionsHeaders(d *schema.ResourceData) (http.Header, error) {
+	tenantID := d.Get("tenant_id").(string)
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant_id is required but is empty")
+	}
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	return headers, nil
+}
+
+func resourceFronteggTenantIPRestrictionsEntries(d fronteggFieldGetter) []fronteggIPRestriction {
+	strategy := serializeIPRestrictionStrategy(d.Get("mode").(string))
+	var entries []fronteggIPRestriction
+	for _, raw := range d.Get("cidr").(*schema.Set).List() {
+		entry := raw.(map[string]interface{})
+		entries = append(entries, fronteggIPRestriction{
+			IP:          entry["cidr"].(string),
+			Description: entry["description"].(string),
+			Strategy:    strategy,
+			IsActive:    true,
+		})
+	}
+	return entries
+}
+
+// diffFronteggIPRestrictions returns the entries to create and delete to go
+// from current to desired. Entries are immutable, so a changed description or
+// strategy replaces the entry.
+func diffFronteggIPRestrictions(current, desired []fronteggIPRestriction) (toCreate, toDelete []fronteggIPRestriction) {
+	key := func(r fronteggIPRestriction) string {
+		return strings.Join([]string{r.IP, r.Description, r.Strategy}, "\x00")
+	}
+	have := map[string]bool{}
+	for _, r := range current {
+		have[key(r)] = true
+	}
+	want := map[string]bool{}
+	for _, r := range desired {
+		want[key(r)] = true
+		if !have[key(r)] {
+			toCreate = append(toCreate, r)
+		}
+	}
+	for _, r := range current {
+		if !want[key(r)] {
+			toDelete = append(toDelete, r)
+		}
+	}
+	return toCreate, toDelete
+}
+
+func listFronteggIPRestrictions(ctx context.Context, client *restclient.Client, headers http.Header) ([]fronteggIPRestriction, error) {
+	var out fronteggIPRestrictionList
+	if err := client.GetWithHeaders(ctx, fronteggIPRestrictionsURL, headers, &out); err != nil {
+		return nil, err
+	}
+	return out.Items, nil
+}
+
+// syncFronteggIPRestrictions writes the config and makes the tenant's entries
+// match desired. New entries are created first, then the config is written,
+// then stale entries are deleted, so the active strategy always has its
+// entries and an allow list never passes through an empty state, including
+// when switching between allow and block.
+func syncFronteggIPRestrictions(ctx context.Context, client *restclient.Client, headers http.Header, config fronteggIPRestrictionsConfig, desired []fronteggIPRestriction) error {
+	current, err := listFronteggIPRestrictions(ctx, client, headers)
+	if err != nil {
+		return err
+	}
+	toCreate, toDelete := diffFronteggIPRestrictions(current, desired)
+	for _, r := range toCreate {
+		if err := client.PostWithHeaders(ctx, fronteggIPRestrictionsURL, headers, r, nil); err != nil {
+			return err
+		}
+	}
+	if err := client.PostWithHeaders(ctx, fronteggIPRestrictionsConfigURL, headers, config, nil); err != nil {
+		return err
+	}
+	for _, r := range toDelete {
+		if err := client.DeleteWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggIPRestrictionsURL, r.ID), headers, nil); err != nil && !restclient.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFronteggTenantIPRestrictionsWrite(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantIPRestrictionsHeaders(d)
+	if err != nil {
+		return err
+	}
+	config := fronteggIPRestrictionsConfig{
+		IsActive: d.Get("enabled").(bool),
+		Strategy: serializeIPRestrictionStrategy(d.Get("mode").(string)),
+	}
+	return syncFronteggIPRestrictions(ctx, &clientHolder.ApiClient, headers, config, resourceFronteggTenantIPRestrictionsEntries(d))
+}
+
+func resourceFronteggTenantIPRestrictionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceFronteggTenantIPRestrictionsWrite(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(d.Get("tenant_id").(string))
+	return resourceFronteggTenantIPRestrictionsRead(ctx, d, meta)
+}
+
+func resourceFronteggTenantIPRestrictionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantIPRestrictionsHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var config fronteggIPRestrictionsConfig
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fronteggIPRestrictionsConfigURL, headers, &config); err != nil {
+		return diag.FromErr(err)
+	}
+	entries, err := listFronteggIPRestrictions(ctx, &clientHolder.ApiClient, headers)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].IP < entries[j].IP })
+	cidrs := make([]map[string]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		cidrs = append(cidrs, map[string]interface{}{
+			"cidr":        entry.IP,
+			"description": entry.Description,
+		})
+	}
+
+	if err := d.Set("enabled", config.IsActive); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("mode", deserializeIPRestrictionStrategy(config.Strategy)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cidr", cidrs); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggTenantIPRestrictionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceFronteggTenantIPRestrictionsWrite(ctx, d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFronteggTenantIPRestrictionsRead(ctx, d, meta)
+}
+
+func resourceFronteggTenantIPRestrictionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantIPRestrictionsHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Disable first, so removing the entries of an allow list cannot lock
+	// the tenant's users out in between.
+	config := fronteggIPRestrictionsConfig{
+		IsActive: false,
+		Strategy: serializeIPRestrictionStrategy(d.Get("mode").(string)),
+	}
+	if err := clientHolder.ApiClient.PostWithHeaders(ctx, fronteggIPRestrictionsConfigURL, headers, config, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := syncFronteggIPRestrictions(ctx, &clientHolder.ApiClient, headers, config, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
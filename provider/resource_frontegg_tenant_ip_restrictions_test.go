@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestValidateFronteggCIDR(t *testing.T) {
+	valid := []string{"203.0.113.0/24", "203.0.113.7", "2001:db8::/32", "0.0.0.0/0"}
+	for _, v := range valid {
+		if _, errs := validateFronteggCIDR(v, "cidr"); len(errs) > 0 {
+			t.Errorf("validateFronteggCIDR(%q) = %v, want no errors", v, errs)
+		}
+	}
+	invalid := []string{"", "203.0.113.0/33", "example.com", "203.0.113.7/24"}
+	for _, v := range invalid {
+		if _, errs := validateFronteggCIDR(v, "cidr"); len(errs) == 0 {
+			t.Errorf("validateFronteggCIDR(%q) returned no errors", v)
+		}
+	}
+}
+
+func TestCheckFronteggIPRestrictions(t *testing.T) {
+	office := fronteggIPRestriction{IP: "203.0.113.0/24", Strategy: "ALLOW"}
+	vpn := fronteggIPRestriction{IP: "198.51.100.0/24", Strategy: "ALLOW"}
+
+	if err := checkFronteggIPRestrictions(true, "allow", nil); err == nil {
+		t.Error("expected an enabled empty allow list to be rejected")
+	}
+	if err := checkFronteggIPRestrictions(false, "allow", nil); err != nil {
+		t.Errorf("expected a disabled empty allow list to be accepted, got %v", err)
+	}
+	if err := checkFronteggIPRestrictions(true, "block", nil); err != nil {
+		t.Errorf("expected an empty block list to be accepted, got %v", err)
+	}
+	if err := checkFronteggIPRestrictions(true, "allow", []fronteggIPRestriction{office, vpn}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	duplicate := office
+	duplicate.Description = "HQ"
+	if err := checkFronteggIPRestrictions(true, "allow", []fronteggIPRestriction{office, duplicate}); err == nil {
+		t.Error("expected a duplicate cidr to be rejected")
+	}
+}
+
+func TestDiffFronteggIPRestrictions(t *testing.T) {
+	current := []fronteggIPRestriction{
+		{ID: "1", IP: "203.0.113.0/24", Description: "Office", Strategy: "ALLOW"},
+		{ID: "2", IP: "198.51.100.0/24", Description: "VPN", Strategy: "ALLOW"},
+		{ID: "3", IP: "192.0.2.0/24", Strategy: "BLOCK"},
+	}
+	desired := []fronteggIPRestriction{
+		{IP: "203.0.113.0/24", Description: "Office", Strategy: "ALLOW"},
+		{IP: "198.51.100.0/24", Description: "Corporate VPN", Strategy: "ALLOW"},
+	}
+	toCreate, toDelete := diffFronteggIPRestrictions(current, desired)
+	if want := desired[1:]; !reflect.DeepEqual(toCreate, want) {
+		t.Errorf("toCreate = %v, want %v", toCreate, want)
+	}
+	if want := current[1:]; !reflect.DeepEqual(toDelete, want) {
+		t.Errorf("toDelete = %v, want %v", toDelete, want)
+	}
+}
+
+func TestSyncFronteggIPRestrictionsModeSwitchNeverEmptiesAllowList(t *testing.T) {
+	config := fronteggIPRestrictionsConfig{IsActive: true, Strategy: "ALLOW"}
+	entries := map[string]fronteggIPRestriction{
+		"ip-1": {ID: "ip-1", IP: "10.0.0.0/8", Strategy: "ALLOW", IsActive: true},
+	}
+	nextID := 2
+	var requests []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == fronteggIPRestrictionsURL:
+			var list fronteggIPRestrictionList
+			for _, e := range entries {
+				list.Items = append(list.Items, e)
+			}
+			_ = json.NewEncoder(w).Encode(list)
+		case r.Method == http.MethodPost && r.URL.Path == fronteggIPRestrictionsURL:
+			var e fronteggIPRestriction
+			_ = json.NewDecoder(r.Body).Decode(&e)
+			e.ID = fmt.Sprintf("ip-%d", nextID)
+			nextID++
+			entries[e.ID] = e
+		case r.Method == http.MethodPost && r.URL.Path == fronteggIPRestrictionsConfigURL:
+			_ = json.NewDecoder(r.Body).Decode(&config)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, fronteggIPRestrictionsURL+"/"):
+			delete(entries, strings.TrimPrefix(r.URL.Path, fronteggIPRestrictionsURL+"/"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if config.IsActive && config.Strategy == "ALLOW" {
+			allowed := 0
+			for _, e := range entries {
+				if e.Strategy == "ALLOW" {
+					allowed++
+				}
+			}
+			if allowed == 0 {
+				t.Errorf("after %s %s: active allow list has no entries", r.Method, r.URL.Path)
+			}
+		}
+	}))
+	defer srv.Close()
+
+	client := restclient.MakeRestClient(srv.URL, "", "")
+	client.Authenticate("test-token")
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", "tenant-1")
+
+	desired := []fronteggIPRestriction{{IP: "192.0.2.0/24", Strategy: "BLOCK", IsActive: true}}
+	err := syncFronteggIPRestrictions(context.Background(), &client, headers,
+		fronteggIPRestrictionsConfig{IsActive: true, Strategy: "BLOCK"}, desired)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if config.Strategy != "BLOCK" || !config.IsActive {
+		t.Errorf("unexpected config %+v", config)
+	}
+	if len(entries) != 1 || entries["ip-2"].IP != "192.0.2.0/24" {
+		t.Errorf("unexpected entries %v", entries)
+	}
+	want := []string{
+		"GET " + fronteggIPRestrictionsURL,
+		"POST " + fronteggIPRestrictionsURL,
+		"POST " + fronteggIPRestrictionsConfigURL,
+		"DELETE " + fronteggIPRestrictionsURL + "/ip-1",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
+
+func TestResourceFronteggTenantIPRestrictionsReadShowsEveryStrategy(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case fronteggIPRestrictionsConfigURL:
+			_ = json.NewEncoder(w).Encode(fronteggIPRestrictionsConfig{IsActive: true, Strategy: "ALLOW"})
+		case fronteggIPRestrictionsURL:
+			_ = json.NewEncoder(w).Encode(fronteggIPRestrictionList{Items: []fronteggIPRestriction{
+				{ID: "ip-1", IP: "10.0.0.0/8", Strategy: "ALLOW", IsActive: true},
+				{ID: "ip-2", IP: "192.0.2.0/24", Strategy: "BLOCK", IsActive: true},
+			}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+
+	d := schema.TestResourceDataRaw(t, resourceFronteggTenantIPRestrictions().Schema, map[string]interface{}{
+		"tenant_id": "tenant-1",
+		"mode":      "allow",
+	})
+	d.SetId("tenant-1")
+	if diags := resourceFronteggTenantIPRestrictionsRead(context.Background(), d, holder); diags.HasError() {
+		t.Fatalf("read: %v", diags)
+	}
+
+	// The block entry is left over from another strategy; it must show up in
+	// state so the plan removes it.
+	var cidrs []string
+	for _, raw := range d.Get("cidr").(*schema.Set).List() {
+		cidrs = append(cidrs, raw.(map[string]interface{})["cidr"].(string))
+	}
+	sort.Strings(cidrs)
+	if !reflect.DeepEqual(cidrs, []string{"10.0.0.0/8", "192.0.2.0/24"}) {
+		t.Errorf("cidr = %v", cidrs)
+	}
+}
@@ -32,7 +32,8 @@ func resourceFronteggTenantOIDCConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: tenantSSOImport,
 		},
-		Schema: s,
+		Identity: tenantSSOIdentity(),
+		Schema:   s,
 	}
 }
 
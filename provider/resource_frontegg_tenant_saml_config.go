@@ -60,7 +60,8 @@ func resourceFronteggTenantSAMLConfig() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: tenantSSOImport,
 		},
-		Schema: s,
+		Identity: tenantSSOIdentity(),
+		Schema:   s,
 	}
 }
 
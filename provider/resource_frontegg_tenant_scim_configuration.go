@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const fronteggTenantSCIMConfigPath = "/directory/resources/v1/configurations/scim2"
+
+type fronteggSCIMConfiguration struct {
+	ID             string `json:"id,omitempty"`
+	ConnectionName string `json:"connectionName"`
+	Source         string `json:"source,omitempty"`
+	// BaseURL and Token are only populated when deserializing an API
+	// response; the token is only returned on creation.
+	BaseURL string `json:"baseUrl,omitempty"`
+	Token   string `json:"token,omitempty"`
+}
+
+func resourceFronteggTenantSCIMConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description: `Configures a SCIM 2.0 provisioning connection for a tenant, letting the tenant's identity provider create,
+update and deactivate its users. The SCIM screen must be enabled in the admin portal
+(` + "`enable_provisioning`" + ` on ` + "`frontegg_admin_portal`" + `) for tenants to see it.
+
+The ` + "`token`" + ` is returned only at creation time and is never retrievable again — store it immediately (e.g. in a secrets manager) or hand it to the identity provider's configuration.
+
+**Import note:** After import, the ` + "`token`" + ` field will be empty in state. Import with an ` + "`identity`" + ` of ` + "`tenant_id`" + ` and ` + "`scim_config_id`" + `, or with the ID format ` + "`tenant_id:scim_config_id`" + `.`,
+
+		CreateContext: resourceFronteggTenantSCIMConfigurationCreate,
+		ReadContext:   resourceFronteggTenantSCIMConfigurationRead,
+		UpdateContext: resourceFronteggTenantSCIMConfigurationUpdate,
+		DeleteContext: resourceFronteggTenantSCIMConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFronteggTenantSCIMConfigurationImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id":      requiredIdentityAttribute("The ID of the tenant that owns the SCIM configuration."),
+					"scim_config_id": requiredIdentityAttribute("The ID of the SCIM configuration."),
+				}
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant that owns the SCIM configuration.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source": {
+				Description:  "The identity provider that provisions users. Must be one of `okta`, `azure-ad` or `generic`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"okta", "azure-ad", "generic"}, false),
+			},
+			"connection_name": {
+				Description: "A human-readable name for the SCIM connection.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"base_url": {
+				Description: "The SCIM 2.0 base URL to configure in the identity provider.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token": {
+				Description: "The bearer token the identity provider authenticates with. Only available at creation time — store it immediately. Cannot be retrieved after creation.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func resourceFronteggTenantSCIMConfigurationHeaders(d *schema.ResourceData) (http.Header, error) {
+	tenantID := d.Get("tenant_id").(string)
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant_id is required but is empty; use 'tenant_id:scim_config_id' format when importing")
+	}
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	return headers, nil
+}
+
+func resourceFronteggTenantSCIMConfigurationSerialize(d *schema.ResourceData) fronteggSCIMConfiguration {
+	return fronteggSCIMConfiguration{
+		ConnectionName: d.Get("connection_name").(string),
+		Source:         d.Get("source").(string),
+	}
+}
+
+func resourceFronteggTenantSCIMConfigurationDeserialize(d *schema.ResourceData, f fronteggSCIMConfiguration) error {
+	d.SetId(f.ID)
+	if err := d.Set("connection_name", f.ConnectionName); err != nil {
+		return err
+	}
+	if err := d.Set("source", f.Source); err != nil {
+		return err
+	}
+	if f.BaseURL != "" {
+		if err := d.Set("base_url", f.BaseURL); err != nil {
+			return err
+		}
+	}
+	// The token is only returned on creation; keep the one already in state.
+	if f.Token != "" {
+		if err := d.Set("token", f.Token); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFronteggTenantSCIMConfigurationSetIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id":      d.Get("tenant_id").(string),
+		"scim_config_id": d.Id(),
+	})
+}
+
+func resourceFronteggTenantSCIMConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantSCIMConfigurationHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	in := resourceFronteggTenantSCIMConfigurationSerialize(d)
+	var out fronteggSCIMConfiguration
+	if err := clientHolder.ApiClient.PostWithHeaders(ctx, fronteggTenantSCIMConfigPath, headers, in, &out); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggTenantSCIMConfigurationDeserialize(d, out); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggTenantSCIMConfigurationSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggTenantSCIMConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantSCIMConfigurationHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	var out []fronteggSCIMConfiguration
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fronteggTenantSCIMConfigPath, headers, &out); err != nil {
+		return diag.FromErr(err)
+	}
+	for _, c := range out {
+		if c.ID == d.Id() {
+			if err := resourceFronteggTenantSCIMConfigurationDeserialize(d, c); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := resourceFronteggTenantSCIMConfigurationSetIdentity(d); err != nil {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceFronteggTenantSCIMConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantSCIMConfigurationHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	in := resourceFronteggTenantSCIMConfigurationSerialize(d)
+	if err := clientHolder.ApiClient.PatchWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggTenantSCIMConfigPath, d.Id()), headers, in, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFronteggTenantSCIMConfigurationRead(ctx, d, meta)
+}
+
+func resourceFronteggTenantSCIMConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggTenantSCIMConfigurationHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := clientHolder.ApiClient.DeleteWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggTenantSCIMConfigPath, d.Id()), headers, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggTenantSCIMConfigurationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := importIdentity(d, ":", "tenant_id", "scim_config_id")
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(ids["scim_config_id"])
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestSCIMConfigurationDeserializeKeepsToken(t *testing.T) {
+	r := resourceFronteggTenantSCIMConfiguration()
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"tenant_id":       "tenant-1",
+		"source":          "okta",
+		"connection_name": "Okta",
+	})
+
+	created := fronteggSCIMConfiguration{
+		ID:             "scim-1",
+		ConnectionName: "Okta",
+		Source:         "okta",
+		BaseURL:        "https://auth.example.com/directory/resources/v1/scim2/scim-1",
+		Token:          "secret-token",
+	}
+	if err := resourceFronteggTenantSCIMConfigurationDeserialize(d, created); err != nil {
+		t.Fatalf("deserialize create response: %v", err)
+	}
+
+	// Reads do not return the token.
+	read := created
+	read.Token = ""
+	read.ConnectionName = "Okta (production)"
+	if err := resourceFronteggTenantSCIMConfigurationDeserialize(d, read); err != nil {
+		t.Fatalf("deserialize read response: %v", err)
+	}
+	if got := d.Get("token").(string); got != "secret-token" {
+		t.Errorf("token = %q, want the token from creation", got)
+	}
+	if got := d.Get("connection_name").(string); got != "Okta (production)" {
+		t.Errorf("connection_name = %q", got)
+	}
+	if got := d.Get("base_url").(string); got != created.BaseURL {
+		t.Errorf("base_url = %q", got)
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// SCIM group mappings have the same shape as SSO ones, see fronteggSSOGroup.
+const fronteggSCIMGroupPath = fronteggTenantSCIMConfigPath + "/%s/groups"
+
+var fronteggTenantSCIMGroupMapping = fronteggTenantGroupMapping{
+	pathFormat:  fronteggSCIMGroupPath,
+	configIDKey: "scim_config_id",
+}
+
+func resourceFronteggTenantSCIMGroupMapping() *schema.Resource {
+	m := fronteggTenantSCIMGroupMapping
+	return &schema.Resource{
+		Description: `Maps a group pushed by the identity provider to one or more Frontegg roles for a tenant SCIM configuration. Users provisioned via SCIM as members of the group are automatically assigned the mapped Frontegg roles.`,
+
+		CreateContext: m.create,
+		ReadContext:   m.read,
+		UpdateContext: m.update,
+		DeleteContext: m.delete,
+		Importer: &schema.ResourceImporter{
+			StateContext: m.importState,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id":      requiredIdentityAttribute("The ID of the tenant that owns the SCIM group mapping."),
+					"scim_config_id": requiredIdentityAttribute("The ID of the SCIM configuration the group mapping belongs to."),
+					"group_id":       requiredIdentityAttribute("The ID of the SCIM group mapping."),
+				}
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tenant_id": {
+				Description: "The ID of the tenant that owns the SCIM group mapping.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scim_config_id": {
+				Description: "The ID of the SCIM configuration to which this group mapping belongs, e.g. `frontegg_tenant_scim_configuration.example.id`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"group": {
+				Description: "The name of the SCIM group to map.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"role_ids": {
+				Description: "The IDs of the Frontegg roles to assign to members of this IdP group. Use the `id` attribute of `frontegg_role` resources.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func scimGroupMappingResourceData(t *testing.T, attrs map[string]interface{}) *schema.ResourceData {
+	t.Helper()
+	r := resourceFronteggTenantSCIMGroupMapping()
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), nil)
+	for k, v := range attrs {
+		if err := d.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return d
+}
+
+func TestFronteggTenantSCIMGroupMappingImport(t *testing.T) {
+	d := scimGroupMappingResourceData(t, nil)
+	d.SetId("tenant-1:scim-1:group-1")
+	got, err := fronteggTenantSCIMGroupMapping.importState(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if got[0].Id() != "group-1" || got[0].Get("tenant_id") != "tenant-1" || got[0].Get("scim_config_id") != "scim-1" {
+		t.Errorf("import: id=%q tenant_id=%q scim_config_id=%q", got[0].Id(), got[0].Get("tenant_id"), got[0].Get("scim_config_id"))
+	}
+}
+
+func TestFronteggTenantSCIMGroupMappingUsesSCIMPath(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		if r.Header.Get("frontegg-tenant-id") != "tenant-1" {
+			t.Errorf("%s %s: missing tenant header", r.Method, r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodPost:
+			_ = json.NewEncoder(w).Encode(fronteggSSOGroup{ID: "group-1", Group: "engineering", RoleIDs: []string{"role-1"}})
+		case http.MethodGet:
+			_ = json.NewEncoder(w).Encode([]fronteggSSOGroup{{ID: "group-1", Group: "engineering", RoleIDs: []string{"role-1"}}})
+		}
+	}))
+	defer srv.Close()
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+
+	d := scimGroupMappingResourceData(t, map[string]interface{}{
+		"tenant_id":      "tenant-1",
+		"scim_config_id": "scim-1",
+		"group":          "engineering",
+		"role_ids":       []interface{}{"role-1"},
+	})
+	if diags := fronteggTenantSCIMGroupMapping.create(context.Background(), d, holder); diags.HasError() {
+		t.Fatalf("create: %v", diags)
+	}
+	if diags := fronteggTenantSCIMGroupMapping.delete(context.Background(), d, holder); diags.HasError() {
+		t.Fatalf("delete: %v", diags)
+	}
+
+	want := []string{
+		"POST " + fronteggTenantSCIMConfigPath + "/scim-1/groups",
+		"DELETE " + fronteggTenantSCIMConfigPath + "/scim-1/groups/group-1",
+	}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("requests = %v, want %v", paths, want)
+	}
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -50,6 +49,26 @@ func tenantSSOHeaders(tenantID string) (http.Header, error) {
 	return h, nil
 }
 
+// tenantSSOIdentity is the resource identity shared by both SAML and OIDC resources.
+func tenantSSOIdentity() *schema.ResourceIdentity {
+	return &schema.ResourceIdentity{
+		SchemaFunc: func() map[string]*schema.Schema {
+			return map[string]*schema.Schema{
+				"tenant_id": requiredIdentityAttribute("The ID of the tenant that owns the SSO configuration."),
+				"config_id": requiredIdentityAttribute("The ID of the SSO configuration."),
+			}
+		},
+	}
+}
+
+// setTenantSSOIdentity records the tenant and configuration IDs as the resource identity.
+func setTenantSSOIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id": d.Get("tenant_id").(string),
+		"config_id": d.Id(),
+	})
+}
+
 // commonSSOSchema returns schema fields shared by both SAML and OIDC resources.
 func commonSSOSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -119,6 +138,9 @@ func tenantSSOCreate(ctx context.Context, d *schema.ResourceData, meta interface
 	if err := deserialize(d, out); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := setTenantSSOIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
@@ -140,6 +162,9 @@ func tenantSSORead(ctx context.Context, d *schema.ResourceData, meta interface{}
 			if err := deserialize(d, c); err != nil {
 				return diag.FromErr(err)
 			}
+			if err := setTenantSSOIdentity(d); err != nil {
+				return diag.FromErr(err)
+			}
 			return nil
 		}
 	}
@@ -176,15 +201,16 @@ func tenantSSODelete(ctx context.Context, d *schema.ResourceData, meta interface
 }
 
 // tenantSSOImport is the shared Import handler for both SAML and OIDC resources.
+// Accepts either a 'tenant_id:config_id' ID or an identity with the same attributes.
 func tenantSSOImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("invalid import ID format: expected 'tenant_id:config_id', got %q", d.Id())
+	ids, err := importIdentity(d, ":", "tenant_id", "config_id")
+	if err != nil {
+		return nil, err
 	}
-	if err := d.Set("tenant_id", parts[0]); err != nil {
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
 		return nil, err
 	}
-	d.SetId(parts[1])
+	d.SetId(ids["config_id"])
 	return []*schema.ResourceData{d}, nil
 }
 
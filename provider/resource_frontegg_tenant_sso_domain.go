@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/frontegg/terraform-provider-frontegg/internal/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -25,14 +26,27 @@ type fronteggTenantSSOConfigWithDomains struct {
 
 func resourceFronteggTenantSSODomain() *schema.Resource {
 	return &schema.Resource{
-		Description: `Associates an email domain with a tenant SSO configuration. Users with email addresses matching this domain will be redirected to the SSO IdP for authentication. After creating the domain, validate ownership by adding the ` + "`txt_record`" + ` value as a DNS TXT record.`,
+		Description: `Associates an email domain with a tenant SSO configuration. Users with email addresses matching this domain will be redirected to the SSO IdP for authentication. After creating the domain, validate ownership by adding the ` + "`txt_record`" + ` value as a DNS TXT record.
+
+Creating a domain that the tenant's domain restrictions block, or leave off the allow list, fails. The check runs against live state while planning and again right before the domain is created, so restrictions written in the same apply are only caught at apply time.`,
 
 		CreateContext: resourceFronteggTenantSSODomainCreate,
 		ReadContext:   resourceFronteggTenantSSODomainRead,
+		UpdateContext: resourceFronteggTenantSSODomainUpdate,
 		DeleteContext: resourceFronteggTenantSSODomainDelete,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceFronteggTenantSSODomainImport,
 		},
+		CustomizeDiff: resourceFronteggTenantSSODomainCustomizeDiff,
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id":     requiredIdentityAttribute("The ID of the tenant that owns the SSO configuration."),
+					"sso_config_id": requiredIdentityAttribute("The ID of the SSO configuration the domain is attached to."),
+					"domain_id":     requiredIdentityAttribute("The ID of the SSO domain."),
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"tenant_id": {
@@ -63,31 +77,71 @@ func resourceFronteggTenantSSODomain() *schema.Resource {
 				Type:        schema.TypeString,
 				Computed:    true,
 			},
+			"wait_for_validation": {
+				Description: `Whether to wait until Frontegg reports the domain as validated. The wait is bounded by the create
+and update timeouts. Since ` + "`txt_record`" + ` is only known once the domain exists, enable this when the TXT record is
+managed outside this configuration, or turn it on in a later apply after the record has been published.`,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
 
-func resourceFronteggTenantSSODomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 3)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return nil, fmt.Errorf("invalid import format, expected tenant_id:sso_config_id:domain_id, got: %s", d.Id())
+// resourceFronteggTenantSSODomainCustomizeDiff fails the plan when the tenant's
+// domain restrictions would keep users of the domain from signing in.
+func resourceFronteggTenantSSODomainCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	clientHolder, ok := meta.(*restclient.ClientHolder)
+	if !ok || !d.HasChange("domain") || !d.NewValueKnown("domain") || !d.NewValueKnown("tenant_id") {
+		return nil
+	}
+	return checkFronteggTenantSSODomainConflicts(ctx, clientHolder, d.Get("tenant_id").(string), d.Get("domain").(string))
+}
+
+// checkFronteggTenantSSODomainConflicts fails if the tenant's live domain
+// restrictions block domain. It runs while planning and again right before
+// the domain is created, since restrictions written earlier in the same apply
+// are not visible to the plan.
+func checkFronteggTenantSSODomainConflicts(ctx context.Context, clientHolder *restclient.ClientHolder, tenantID, domain string) error {
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	allowed, blocked, err := fetchFronteggDomainRestrictions(ctx, &clientHolder.ApiClient, headers)
+	recordFronteggDomainConflictCheck(clientHolder, "frontegg_tenant_sso_domain", tenantID, "domain restrictions", err)
+	if err != nil {
+		return nil
 	}
+	if conflicts := fronteggDomainRestrictionConflicts(allowed, blocked, []string{domain}); len(conflicts) > 0 {
+		return fmt.Errorf("tenant %s domain restrictions conflict: %s", tenantID, strings.Join(conflicts, ", "))
+	}
+	return nil
+}
 
-	tenantID := parts[0]
-	ssoConfigID := parts[1]
-	domainID := parts[2]
+func resourceFronteggTenantSSODomainImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := importIdentity(d, ":", "tenant_id", "sso_config_id", "domain_id")
+	if err != nil {
+		return nil, err
+	}
 
-	if err := d.Set("tenant_id", tenantID); err != nil {
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
 		return nil, err
 	}
-	if err := d.Set("sso_config_id", ssoConfigID); err != nil {
+	if err := d.Set("sso_config_id", ids["sso_config_id"]); err != nil {
 		return nil, err
 	}
-	d.SetId(domainID)
+	d.SetId(ids["domain_id"])
 
 	return []*schema.ResourceData{d}, nil
 }
 
+func resourceFronteggTenantSSODomainSetIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id":     d.Get("tenant_id").(string),
+		"sso_config_id": d.Get("sso_config_id").(string),
+		"domain_id":     d.Id(),
+	})
+}
+
 func resourceFronteggTenantSSODomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
 	tenantID := d.Get("tenant_id").(string)
@@ -97,6 +151,10 @@ func resourceFronteggTenantSSODomainCreate(ctx context.Context, d *schema.Resour
 	ssoConfigID := d.Get("sso_config_id").(string)
 	domain := d.Get("domain").(string)
 
+	if err := checkFronteggTenantSSODomainConflicts(ctx, clientHolder, tenantID, domain); err != nil {
+		return diag.FromErr(err)
+	}
+
 	headers := http.Header{}
 	headers.Add("frontegg-tenant-id", tenantID)
 
@@ -124,7 +182,14 @@ func resourceFronteggTenantSSODomainCreate(ctx context.Context, d *schema.Resour
 	if err := d.Set("txt_record", out.TxtRecord); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	if err := resourceFronteggTenantSSODomainSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	diags := fronteggDomainConflictCheckWarning(clientHolder, "frontegg_tenant_sso_domain", tenantID)
+	if d.Get("wait_for_validation").(bool) {
+		return append(diags, resourceFronteggTenantSSODomainWaitForValidation(ctx, d, meta)...)
+	}
+	return diags
 }
 
 func resourceFronteggTenantSSODomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -184,9 +249,67 @@ func resourceFronteggTenantSSODomainRead(ctx context.Context, d *schema.Resource
 	if err := d.Set("txt_record", found.TxtRecord); err != nil {
 		return diag.FromErr(err)
 	}
+	// wait_for_validation only affects applies; keep the configured value (or
+	// the default, after an import).
+	if err := d.Set("wait_for_validation", d.Get("wait_for_validation").(bool)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggTenantSSODomainSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceFronteggTenantSSODomainUpdate only handles wait_for_validation; every
+// other argument forces a new resource.
+func resourceFronteggTenantSSODomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("wait_for_validation") && d.Get("wait_for_validation").(bool) {
+		if diags := resourceFronteggTenantSSODomainWaitForValidation(ctx, d, meta); diags.HasError() {
+			return diags
+		}
+	}
+	return resourceFronteggTenantSSODomainRead(ctx, d, meta)
+}
+
+func resourceFronteggTenantSSODomainWaitForValidation(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", d.Get("tenant_id").(string))
+
+	if _, err := waiter.Wait(ctx, &clientHolder.ApiClient, fronteggTenantSSODomainValidationWait(
+		d.Get("sso_config_id").(string), d.Id(), d.Get("domain").(string), headers,
+	)); err != nil {
+		return waiter.Diagnostics(err)
+	}
+	if err := d.Set("validated", true); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
+// fronteggTenantSSODomainValidationWait waits until the domain with domainID
+// on the SSO configuration ssoConfigID is validated.
+func fronteggTenantSSODomainValidationWait(ssoConfigID, domainID, domain string, headers http.Header) waiter.Config[[]fronteggTenantSSOConfigWithDomains] {
+	return waiter.Config[[]fronteggTenantSSOConfigWithDomains]{
+		Description: fmt.Sprintf("SSO domain %q to be validated via its DNS TXT record", domain),
+		Path:        fronteggTenantSSOConfigPath,
+		Headers:     headers,
+		Check: func(out *[]fronteggTenantSSOConfigWithDomains) (waiter.Result, error) {
+			for _, config := range *out {
+				if config.ID != ssoConfigID {
+					continue
+				}
+				for _, found := range config.Domains {
+					if found.ID == domainID {
+						return waiter.Result{Status: "not validated", Done: found.Validated}, nil
+					}
+				}
+			}
+			return waiter.Result{}, fmt.Errorf("SSO domain %s not found on SSO configuration %s", domainID, ssoConfigID)
+		},
+	}
+}
+
 func resourceFronteggTenantSSODomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
 	tenantID := d.Get("tenant_id").(string)
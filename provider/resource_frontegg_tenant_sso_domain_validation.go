@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -23,6 +22,14 @@ func resourceFronteggTenantSSODomainValidation() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceFronteggTenantSSODomainValidationImport,
 		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id": requiredIdentityAttribute("The ID of the tenant that owns the SSO domain."),
+					"domain":    requiredIdentityAttribute("The validated domain name."),
+				}
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"domain": {
@@ -47,12 +54,12 @@ func resourceFronteggTenantSSODomainValidation() *schema.Resource {
 }
 
 func resourceFronteggTenantSSODomainValidationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 2)
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		return nil, fmt.Errorf("invalid import format, expected tenant_id:domain, got: %s", d.Id())
+	ids, err := importIdentity(d, ":", "tenant_id", "domain")
+	if err != nil {
+		return nil, err
 	}
-	tenantID := parts[0]
-	domain := parts[1]
+	tenantID := ids["tenant_id"]
+	domain := ids["domain"]
 
 	if err := d.Set("tenant_id", tenantID); err != nil {
 		return nil, err
@@ -93,15 +100,28 @@ func resourceFronteggTenantSSODomainValidationApply(ctx context.Context, d *sche
 	if err := d.Set("validated", validated); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := resourceFronteggTenantSSODomainValidationSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
+func resourceFronteggTenantSSODomainValidationSetIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id": d.Get("tenant_id").(string),
+		"domain":    d.Get("domain").(string),
+	})
+}
+
 func resourceFronteggTenantSSODomainValidationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return resourceFronteggTenantSSODomainValidationApply(ctx, d, meta, d.Get("validated").(bool))
 }
 
-func resourceFronteggTenantSSODomainValidationRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+func resourceFronteggTenantSSODomainValidationRead(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
 	// Validation state is fully managed; there is no read endpoint.
+	if err := resourceFronteggTenantSSODomainValidationSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
 	return nil
 }
 
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"net/http"
-	"strings"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -19,16 +18,44 @@ type fronteggSSOGroup struct {
 	RoleIDs []string `json:"roleIds,omitempty"`
 }
 
+// fronteggTenantGroupMapping implements the CRUD of the resources mapping an
+// IdP group to roles within a tenant's SSO or SCIM configuration, which only
+// differ in the path of the groups and the attribute holding the
+// configuration ID.
+type fronteggTenantGroupMapping struct {
+	// pathFormat is the path of a configuration's groups, with a %s for the
+	// configuration ID.
+	pathFormat string
+	// configIDKey is the attribute holding the configuration ID, which is
+	// also its identity attribute and the middle part of the import ID.
+	configIDKey string
+}
+
+var fronteggTenantSSOGroupMapping = fronteggTenantGroupMapping{
+	pathFormat:  fronteggSSOGroupPath,
+	configIDKey: "sso_config_id",
+}
+
 func resourceFronteggTenantSSOGroupMapping() *schema.Resource {
+	m := fronteggTenantSSOGroupMapping
 	return &schema.Resource{
 		Description: `Maps an IdP group to one or more Frontegg roles for a tenant SSO configuration. When a user authenticates via SSO and belongs to the specified IdP group, they are automatically assigned the mapped Frontegg roles.`,
 
-		CreateContext: resourceFronteggTenantSSOGroupMappingCreate,
-		ReadContext:   resourceFronteggTenantSSOGroupMappingRead,
-		UpdateContext: resourceFronteggTenantSSOGroupMappingUpdate,
-		DeleteContext: resourceFronteggTenantSSOGroupMappingDelete,
+		CreateContext: m.create,
+		ReadContext:   m.read,
+		UpdateContext: m.update,
+		DeleteContext: m.delete,
 		Importer: &schema.ResourceImporter{
-			StateContext: resourceFronteggTenantSSOGroupMappingImport,
+			StateContext: m.importState,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"tenant_id":     requiredIdentityAttribute("The ID of the tenant that owns the SSO group mapping."),
+					"sso_config_id": requiredIdentityAttribute("The ID of the SSO configuration the group mapping belongs to."),
+					"group_id":      requiredIdentityAttribute("The ID of the SSO group mapping."),
+				}
+			},
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -59,17 +86,21 @@ func resourceFronteggTenantSSOGroupMapping() *schema.Resource {
 	}
 }
 
-func resourceFronteggTenantSSOGroupMappingHeaders(d *schema.ResourceData) (http.Header, error) {
+func (m fronteggTenantGroupMapping) path(d *schema.ResourceData) string {
+	return fmt.Sprintf(m.pathFormat, d.Get(m.configIDKey).(string))
+}
+
+func (m fronteggTenantGroupMapping) headers(d *schema.ResourceData) (http.Header, error) {
 	tenantID := d.Get("tenant_id").(string)
 	if tenantID == "" {
-		return nil, fmt.Errorf("tenant_id is required but is empty; use 'tenant_id:sso_config_id:group_id' format when importing")
+		return nil, fmt.Errorf("tenant_id is required but is empty; use 'tenant_id:%s:group_id' format when importing", m.configIDKey)
 	}
 	headers := http.Header{}
 	headers.Add("frontegg-tenant-id", tenantID)
 	return headers, nil
 }
 
-func resourceFronteggTenantSSOGroupMappingSerialize(d *schema.ResourceData) fronteggSSOGroup {
+func (m fronteggTenantGroupMapping) serialize(d *schema.ResourceData) fronteggSSOGroup {
 	rawRoleIDs := d.Get("role_ids").(*schema.Set).List()
 	roleIDs := make([]string, len(rawRoleIDs))
 	for i, v := range rawRoleIDs {
@@ -81,7 +112,7 @@ func resourceFronteggTenantSSOGroupMappingSerialize(d *schema.ResourceData) fron
 	}
 }
 
-func resourceFronteggTenantSSOGroupMappingDeserialize(d *schema.ResourceData, f fronteggSSOGroup) error {
+func (m fronteggTenantGroupMapping) deserialize(d *schema.ResourceData, f fronteggSSOGroup) error {
 	d.SetId(f.ID)
 	if err := d.Set("group", f.Group); err != nil {
 		return err
@@ -92,42 +123,52 @@ func resourceFronteggTenantSSOGroupMappingDeserialize(d *schema.ResourceData, f
 	return nil
 }
 
-func resourceFronteggTenantSSOGroupMappingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (m fronteggTenantGroupMapping) setIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"tenant_id":   d.Get("tenant_id").(string),
+		m.configIDKey: d.Get(m.configIDKey).(string),
+		"group_id":    d.Id(),
+	})
+}
+
+func (m fronteggTenantGroupMapping) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
-	headers, err := resourceFronteggTenantSSOGroupMappingHeaders(d)
+	headers, err := m.headers(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	ssoConfigID := d.Get("sso_config_id").(string)
-	path := fmt.Sprintf(fronteggSSOGroupPath, ssoConfigID)
 
-	in := resourceFronteggTenantSSOGroupMappingSerialize(d)
+	in := m.serialize(d)
 	var out fronteggSSOGroup
-	if err := clientHolder.ApiClient.PostWithHeaders(ctx, path, headers, in, &out); err != nil {
+	if err := clientHolder.ApiClient.PostWithHeaders(ctx, m.path(d), headers, in, &out); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := m.deserialize(d, out); err != nil {
 		return diag.FromErr(err)
 	}
-	if err := resourceFronteggTenantSSOGroupMappingDeserialize(d, out); err != nil {
+	if err := m.setIdentity(d); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceFronteggTenantSSOGroupMappingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (m fronteggTenantGroupMapping) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
-	headers, err := resourceFronteggTenantSSOGroupMappingHeaders(d)
+	headers, err := m.headers(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	ssoConfigID := d.Get("sso_config_id").(string)
-	path := fmt.Sprintf(fronteggSSOGroupPath, ssoConfigID)
 
 	var out []fronteggSSOGroup
-	if err := clientHolder.ApiClient.GetWithHeaders(ctx, path, headers, &out); err != nil {
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, m.path(d), headers, &out); err != nil {
 		return diag.FromErr(err)
 	}
 	for _, g := range out {
 		if g.ID == d.Id() {
-			if err := resourceFronteggTenantSSOGroupMappingDeserialize(d, g); err != nil {
+			if err := m.deserialize(d, g); err != nil {
+				return diag.FromErr(err)
+			}
+			if err := m.setIdentity(d); err != nil {
 				return diag.FromErr(err)
 			}
 			return nil
@@ -137,51 +178,44 @@ func resourceFronteggTenantSSOGroupMappingRead(ctx context.Context, d *schema.Re
 	return nil
 }
 
-func resourceFronteggTenantSSOGroupMappingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (m fronteggTenantGroupMapping) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
-	headers, err := resourceFronteggTenantSSOGroupMappingHeaders(d)
+	headers, err := m.headers(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	ssoConfigID := d.Get("sso_config_id").(string)
-	path := fmt.Sprintf("%s/%s", fmt.Sprintf(fronteggSSOGroupPath, ssoConfigID), d.Id())
 
-	in := resourceFronteggTenantSSOGroupMappingSerialize(d)
-	if err := clientHolder.ApiClient.PatchWithHeaders(ctx, path, headers, in, nil); err != nil {
+	in := m.serialize(d)
+	if err := clientHolder.ApiClient.PatchWithHeaders(ctx, fmt.Sprintf("%s/%s", m.path(d), d.Id()), headers, in, nil); err != nil {
 		return diag.FromErr(err)
 	}
-	return resourceFronteggTenantSSOGroupMappingRead(ctx, d, meta)
+	return m.read(ctx, d, meta)
 }
 
-func resourceFronteggTenantSSOGroupMappingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func (m fronteggTenantGroupMapping) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	clientHolder := meta.(*restclient.ClientHolder)
-	headers, err := resourceFronteggTenantSSOGroupMappingHeaders(d)
+	headers, err := m.headers(d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	ssoConfigID := d.Get("sso_config_id").(string)
-	path := fmt.Sprintf("%s/%s", fmt.Sprintf(fronteggSSOGroupPath, ssoConfigID), d.Id())
 
-	if err := clientHolder.ApiClient.DeleteWithHeaders(ctx, path, headers, nil); err != nil {
+	if err := clientHolder.ApiClient.DeleteWithHeaders(ctx, fmt.Sprintf("%s/%s", m.path(d), d.Id()), headers, nil); err != nil {
 		return diag.FromErr(err)
 	}
 	return nil
 }
 
-func resourceFronteggTenantSSOGroupMappingImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	parts := strings.SplitN(d.Id(), ":", 3)
-	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
-		return nil, fmt.Errorf("invalid import ID format, expected tenant_id:sso_config_id:group_id, got: %s", d.Id())
+func (m fronteggTenantGroupMapping) importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	ids, err := importIdentity(d, ":", "tenant_id", m.configIDKey, "group_id")
+	if err != nil {
+		return nil, err
 	}
-	tenantID := parts[0]
-	ssoConfigID := parts[1]
-	groupID := parts[2]
 
-	d.SetId(groupID)
-	if err := d.Set("tenant_id", tenantID); err != nil {
+	d.SetId(ids["group_id"])
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
 		return nil, err
 	}
-	if err := d.Set("sso_config_id", ssoConfigID); err != nil {
+	if err := d.Set(m.configIDKey, ids[m.configIDKey]); err != nil {
 		return nil, err
 	}
 	return []*schema.ResourceData{d}, nil
@@ -20,19 +20,30 @@ type fronteggUserRole struct {
 	Key string `json:"key,omitempty"`
 }
 
+type fronteggUserTenant struct {
+	TenantID string             `json:"tenantId,omitempty"`
+	Roles    []fronteggUserRole `json:"roles,omitempty"`
+}
+
 type fronteggUser struct {
 	Key             string             `json:"id,omitempty"`
 	Email           string             `json:"email,omitempty"`
+	Name            string             `json:"name,omitempty"`
 	Password        string             `json:"password,omitempty"`
 	CreateRoleIDs   []interface{}      `json:"roleIds,omitempty"`
 	ReadRoleIDs     []fronteggUserRole `json:"roles,omitempty"`
 	SkipInviteEmail bool               `json:"skipInviteEmail,omitempty"`
 	Verified        bool               `json:"verified,omitempty"`
 	SuperUser       bool               `json:"superUser,omitempty"`
+	// The fields below are only populated when deserializing an API response.
+	TenantID string               `json:"tenantId,omitempty"`
+	Tenants  []fronteggUserTenant `json:"tenants,omitempty"`
+	Metadata string               `json:"metadata,omitempty"`
 }
 
 const fronteggUserPath = "/identity/resources/users/v2"
 const fronteggUserPathV1 = "/identity/resources/users/v1"
+const fronteggUserPathV3 = "/identity/resources/users/v3"
 
 func resourceFronteggUser() *schema.Resource {
 	return &schema.Resource{
@@ -87,6 +98,7 @@ func resourceFronteggUser() *schema.Resource {
 				Type:        schema.TypeBool,
 				Optional:    true,
 			},
+			"deletion_protection": deletionProtectionSchema(),
 		},
 	}
 }
@@ -114,6 +126,9 @@ func resourceFronteggUserDeserialize(d *schema.ResourceData, f fronteggUser) err
 	if err := d.Set("role_ids", roleIDs); err != nil {
 		return err
 	}
+	if err := preserveDeletionProtection(d); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -173,6 +188,9 @@ func resourceFronteggUserRead(ctx context.Context, d *schema.ResourceData, meta
 }
 
 func resourceFronteggUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(d, "frontegg_user"); diags.HasError() {
+		return diags
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	if err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggUserPathV1, d.Id()), nil); err != nil {
 		return diag.FromErr(err)
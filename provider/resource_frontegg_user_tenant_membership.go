@@ -0,0 +1,256 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type fronteggUserTenantMembership struct {
+	TenantID        string   `json:"tenantId"`
+	RoleIDs         []string `json:"roleIds,omitempty"`
+	SkipInviteEmail bool     `json:"skipInviteEmail,omitempty"`
+	// ValidateTenantExist makes Frontegg reject unknown tenants instead of
+	// creating them on the fly.
+	ValidateTenantExist bool `json:"validateTenantExist"`
+}
+
+func resourceFronteggUserTenantMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: `Adds an existing Frontegg user to an additional tenant and manages the user's roles in that tenant.
+
+The user's primary tenant, managed by ` + "`frontegg_user`" + `, is left untouched; use ` + "`frontegg_user.role_ids`" + ` for
+the roles in the primary tenant.`,
+
+		CreateContext: resourceFronteggUserTenantMembershipCreate,
+		ReadContext:   resourceFronteggUserTenantMembershipRead,
+		UpdateContext: resourceFronteggUserTenantMembershipUpdate,
+		DeleteContext: resourceFronteggUserTenantMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceFronteggUserTenantMembershipImport,
+		},
+		Identity: &schema.ResourceIdentity{
+			SchemaFunc: func() map[string]*schema.Schema {
+				return map[string]*schema.Schema{
+					"user_id":   requiredIdentityAttribute("The ID of the user."),
+					"tenant_id": requiredIdentityAttribute("The ID of the tenant the user is a member of."),
+				}
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Description: "The ID of the user, e.g. `frontegg_user.example.id`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"tenant_id": {
+				Description: "The ID of the tenant to add the user to. Must not be the user's primary tenant.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"role_ids": {
+				Description: "The IDs of the roles the user has in the tenant.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"skip_invite_email": {
+				Description: "Skip sending the user an email about being added to the tenant. Only used on creation.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceFronteggUserTenantMembershipHeaders(d *schema.ResourceData) (http.Header, error) {
+	tenantID := d.Get("tenant_id").(string)
+	if tenantID == "" {
+		return nil, fmt.Errorf("tenant_id is required but is empty; use 'user_id:tenant_id' format when importing")
+	}
+	headers := http.Header{}
+	headers.Add("frontegg-tenant-id", tenantID)
+	return headers, nil
+}
+
+func resourceFronteggUserTenantMembershipSetIdentity(d *schema.ResourceData) error {
+	return setIdentity(d, map[string]interface{}{
+		"user_id":   d.Get("user_id").(string),
+		"tenant_id": d.Get("tenant_id").(string),
+	})
+}
+
+// fronteggUserIsTenantMember reports whether u belongs to tenantID. Responses
+// that do not list the user's tenants are already scoped to the requested one.
+func fronteggUserIsTenantMember(u fronteggUser, tenantID string) bool {
+	if len(u.Tenants) == 0 {
+		return true
+	}
+	for _, tenant := range u.Tenants {
+		if tenant.TenantID == tenantID {
+			return true
+		}
+	}
+	return false
+}
+
+// checkFronteggUserSecondaryTenant fails when tenantID is the user's primary
+// tenant, which frontegg_user manages. found is false when the user is gone.
+func checkFronteggUserSecondaryTenant(ctx context.Context, clientHolder *restclient.ClientHolder, userID, tenantID string) (found bool, err error) {
+	var user fronteggUser
+	if err := clientHolder.ApiClient.Get(ctx, fmt.Sprintf("%s/%s", fronteggUserPathV1, userID), &user); err != nil {
+		if restclient.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if user.TenantID == tenantID {
+		return true, fmt.Errorf("tenant %q is the primary tenant of user %q; manage its roles with frontegg_user instead", tenantID, userID)
+	}
+	return true, nil
+}
+
+// updateFronteggUserTenantRoles adds and removes the user's roles in the
+// tenant of headers to go from olds to news.
+func updateFronteggUserTenantRoles(ctx context.Context, clientHolder *restclient.ClientHolder, userID string, headers http.Header, olds, news *schema.Set) error {
+	path := fmt.Sprintf("%s/%s/roles", fronteggUserPathV1, userID)
+	if toAdd := stringSetToList(news.Difference(olds)); len(toAdd) > 0 {
+		if err := clientHolder.ApiClient.PostWithHeaders(ctx, path, headers, struct {
+			RoleIds []string `json:"roleIds"`
+		}{toAdd}, nil); err != nil {
+			return err
+		}
+	}
+	if toDel := stringSetToList(olds.Difference(news)); len(toDel) > 0 {
+		if err := clientHolder.ApiClient.RequestWithHeaders(ctx, "DELETE", path, headers, struct {
+			RoleIds []string `json:"roleIds"`
+		}{toDel}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceFronteggUserTenantMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	userID := d.Get("user_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+
+	found, err := checkFronteggUserSecondaryTenant(ctx, clientHolder, userID, tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		return diag.Errorf("unable to find user %q", userID)
+	}
+
+	in := fronteggUserTenantMembership{
+		TenantID:            tenantID,
+		RoleIDs:             stringSetToList(d.Get("role_ids").(*schema.Set)),
+		SkipInviteEmail:     d.Get("skip_invite_email").(bool),
+		ValidateTenantExist: true,
+	}
+	if err := clientHolder.ApiClient.Post(ctx, fmt.Sprintf("%s/%s/tenant", fronteggUserPathV1, userID), in, nil); err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(fmt.Sprintf("%s:%s", userID, tenantID))
+	if err := resourceFronteggUserTenantMembershipSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return resourceFronteggUserTenantMembershipRead(ctx, d, meta)
+}
+
+func resourceFronteggUserTenantMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggUserTenantMembershipHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	tenantID := d.Get("tenant_id").(string)
+
+	var out fronteggUser
+	if err := clientHolder.ApiClient.GetWithHeaders(ctx, fmt.Sprintf("%s/%s", fronteggUserPathV1, d.Get("user_id").(string)), headers, &out); err != nil {
+		if restclient.IsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	if out.Key == "" || !fronteggUserIsTenantMember(out, tenantID) {
+		d.SetId("")
+		return nil
+	}
+	if err := d.Set("role_ids", fronteggUserRoleIDs(out, tenantID)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := resourceFronteggUserTenantMembershipSetIdentity(d); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggUserTenantMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	headers, err := resourceFronteggUserTenantMembershipHeaders(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if d.HasChange("role_ids") {
+		olds, news := d.GetChange("role_ids")
+		if err := updateFronteggUserTenantRoles(ctx, clientHolder, d.Get("user_id").(string), headers, olds.(*schema.Set), news.(*schema.Set)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	return resourceFronteggUserTenantMembershipRead(ctx, d, meta)
+}
+
+func resourceFronteggUserTenantMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	clientHolder := meta.(*restclient.ClientHolder)
+	userID := d.Get("user_id").(string)
+	tenantID := d.Get("tenant_id").(string)
+	// Removing the user from its primary tenant would orphan the user.
+	found, err := checkFronteggUserSecondaryTenant(ctx, clientHolder, userID, tenantID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !found {
+		return nil
+	}
+	in := struct {
+		TenantID string `json:"tenantId"`
+	}{tenantID}
+	if err := clientHolder.ApiClient.RequestWithHeaders(ctx, "DELETE", fmt.Sprintf("%s/%s/tenant", fronteggUserPathV1, userID), nil, in, nil); err != nil {
+		if restclient.IsNotFound(err) {
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func resourceFronteggUserTenantMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	clientHolder := meta.(*restclient.ClientHolder)
+	ids, err := importIdentity(d, ":", "user_id", "tenant_id")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := checkFronteggUserSecondaryTenant(ctx, clientHolder, ids["user_id"], ids["tenant_id"]); err != nil {
+		return nil, err
+	}
+	d.SetId(fmt.Sprintf("%s:%s", ids["user_id"], ids["tenant_id"]))
+	if err := d.Set("user_id", ids["user_id"]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("tenant_id", ids["tenant_id"]); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,112 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFronteggUserIsTenantMember(t *testing.T) {
+	user := fronteggUser{
+		TenantID: "primary",
+		Tenants: []fronteggUserTenant{
+			{TenantID: "primary"},
+			{TenantID: "customer-a", Roles: []fronteggUserRole{{Id: "viewer"}}},
+		},
+	}
+	if !fronteggUserIsTenantMember(user, "customer-a") {
+		t.Error("expected user to be a member of customer-a")
+	}
+	if fronteggUserIsTenantMember(user, "customer-b") {
+		t.Error("expected user not to be a member of customer-b")
+	}
+	// A response scoped by the tenant header does not list tenants.
+	if !fronteggUserIsTenantMember(fronteggUser{Key: "u1"}, "customer-b") {
+		t.Error("expected a tenant-scoped response to count as membership")
+	}
+}
+
+func userTenantMembershipTestServer(t *testing.T, requests *[]string) *restclient.ClientHolder {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		req := r.Method + " " + r.URL.Path
+		if tenantID := r.Header.Get("frontegg-tenant-id"); tenantID != "" {
+			req += " " + tenantID
+		}
+		*requests = append(*requests, strings.TrimSpace(req+" "+string(body)))
+		if r.Method == http.MethodGet {
+			_ = json.NewEncoder(w).Encode(fronteggUser{Key: "u1", TenantID: "primary"})
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	holder := &restclient.ClientHolder{ApiClient: restclient.MakeRestClient(srv.URL, "", "")}
+	holder.ApiClient.Authenticate("test-token")
+	return holder
+}
+
+func TestUpdateFronteggUserTenantRoles(t *testing.T) {
+	var requests []string
+	holder := userTenantMembershipTestServer(t, &requests)
+	headers := http.Header{}
+	headers.Set("frontegg-tenant-id", "customer-a")
+
+	olds := schema.NewSet(schema.HashString, []interface{}{"admin", "viewer"})
+	news := schema.NewSet(schema.HashString, []interface{}{"viewer", "editor"})
+	if err := updateFronteggUserTenantRoles(context.Background(), holder, "u1", headers, olds, news); err != nil {
+		t.Fatalf("update roles: %v", err)
+	}
+
+	want := []string{
+		`POST ` + fronteggUserPathV1 + `/u1/roles customer-a {"roleIds":["editor"]}`,
+		`DELETE ` + fronteggUserPathV1 + `/u1/roles customer-a {"roleIds":["admin"]}`,
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestUserTenantMembershipRefusesPrimaryTenant(t *testing.T) {
+	var requests []string
+	holder := userTenantMembershipTestServer(t, &requests)
+	r := resourceFronteggUserTenantMembership()
+
+	d := schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), nil)
+	d.SetId("u1:primary")
+	if _, err := resourceFronteggUserTenantMembershipImport(context.Background(), d, holder); err == nil {
+		t.Error("expected importing the primary tenant to fail")
+	}
+
+	d = schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), nil)
+	_ = d.Set("user_id", "u1")
+	_ = d.Set("tenant_id", "primary")
+	d.SetId("u1:primary")
+	if diags := resourceFronteggUserTenantMembershipDelete(context.Background(), d, holder); !diags.HasError() {
+		t.Error("expected deleting the primary tenant membership to fail")
+	}
+	for _, req := range requests {
+		if strings.HasPrefix(req, http.MethodDelete) {
+			t.Errorf("unexpected request %q", req)
+		}
+	}
+
+	d = schema.TestResourceDataWithIdentityRaw(t, r.Schema, r.Identity.SchemaMap(), nil)
+	_ = d.Set("user_id", "u1")
+	_ = d.Set("tenant_id", "customer-a")
+	d.SetId("u1:customer-a")
+	if diags := resourceFronteggUserTenantMembershipDelete(context.Background(), d, holder); diags.HasError() {
+		t.Fatalf("delete: %v", diags)
+	}
+	if last := requests[len(requests)-1]; last != `DELETE `+fronteggUserPathV1+`/u1/tenant {"tenantId":"customer-a"}` {
+		t.Errorf("last request = %q", last)
+	}
+}
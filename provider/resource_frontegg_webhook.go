@@ -37,6 +37,9 @@ func resourceFronteggWebhook() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return eventCatalogCustomizeDiff(ctx, d, meta, fronteggEventKindWebhook)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"enabled": {
@@ -65,10 +68,12 @@ func resourceFronteggWebhook() *schema.Resource {
 				Required:    true,
 			},
 			"events": {
-				Description: "The names of the events to subscribe to.",
+				Description: "The names of the events to subscribe to. Checked at plan time against the `frontegg_event_catalog`.",
 				Type:        schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
+					// Kept alongside the catalog check, which is skipped
+					// when the catalog cannot be fetched.
 					ValidateFunc: validation.StringMatch(
 						regexp.MustCompile(`^frontegg\..*`),
 						"event name must start with 'frontegg.'",
@@ -151,7 +156,7 @@ func resourceFronteggWebhookCreate(ctx context.Context, d *schema.ResourceData,
 	if err := resourceFronteggWebhookDeserialize(d, out); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return eventCatalogSkippedWarning(clientHolder, fronteggEventKindWebhook)
 }
 
 func resourceFronteggWebhookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -181,7 +186,7 @@ func resourceFronteggWebhookUpdate(ctx context.Context, d *schema.ResourceData,
 	if err := resourceFronteggWebhookDeserialize(d, out); err != nil {
 		return diag.FromErr(err)
 	}
-	return nil
+	return eventCatalogSkippedWarning(clientHolder, fronteggEventKindWebhook)
 }
 
 func resourceFronteggWebhookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
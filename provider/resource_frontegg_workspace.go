@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/frontegg/terraform-provider-frontegg/internal/restclient"
+	"github.com/frontegg/terraform-provider-frontegg/internal/waiter"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -262,17 +263,25 @@ func resourceFronteggWorkspace() *schema.Resource {
 This is a singleton resource. You must only create one frontegg_workspace resource
 per Frontegg provider.
 
-**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the workspace will remain in its last-applied state.`,
+**Note:** This resource cannot be deleted. When destroyed, Terraform will remove it from the state file, but the workspace will remain in its last-applied state, unless ` + "`on_destroy = \"reset\"`" + ` is set.`,
 
 		CreateContext: resourceFronteggWorkspaceCreate,
 		ReadContext:   resourceFronteggWorkspaceRead,
 		UpdateContext: resourceFronteggWorkspaceUpdate,
 		DeleteContext: resourceFronteggWorkspaceDelete,
-		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+		Importer:      onDestroyImporter(resourceFronteggWorkspace),
+		// With wait_for_active, applies wait for every custom domain to become
+		// active, which can take well past the default timeout.
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(fronteggDefaultTimeout),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(fronteggDefaultTimeout),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"on_destroy":          onDestroySchema(),
+			"on_destroy_snapshot": onDestroySnapshotSchema(),
 			"name": {
 				Description: "The name of the workspace.",
 				Type:        schema.TypeString,
@@ -313,13 +322,23 @@ per Frontegg provider.
 			},
 			"custom_domains": {
 				Description: `List of custom domains at which Frontegg services will be reachable.
-				You must configure CNAME for each domain, you can get record values from the portal.`,
+				You must configure CNAME for each domain, you can get record values from the portal.
+				Only the domains listed here are managed: domains added elsewhere, such as with
+				` + "`frontegg_custom_domain`" + `, are neither tracked nor removed. Domains removed from the list,
+				including by leaving it empty, are deleted.`,
 				Type: schema.TypeSet,
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 				Optional: true,
 			},
+			"wait_for_active": {
+				Description: `Whether to wait, after adding custom domains, until every domain in ` + "`custom_domains`" + ` reports the
+` + "`Active`" + ` status. The wait is bounded by the create and update timeouts.`,
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"allowed_origins": {
 				Description: `The origins that are allowed to access the workspace.
 
@@ -559,14 +578,20 @@ Must be one of "off", "on", or "unless-saml".`,
 }
 
 func resourceFronteggWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := captureOnDestroySnapshot(ctx, resourceFronteggWorkspace(), d, meta); diags.HasError() {
+		return diags
+	}
 	return resourceFronteggWorkspaceUpdate(ctx, d, meta)
 }
 
 func resourceFronteggWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := preserveOnDestroy(d); err != nil {
+		return diag.FromErr(err)
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	{
-		var out fronteggVendor
-		if err := clientHolder.ApiClient.Get(ctx, fronteggVendorURL, &out); err != nil {
+		out, err := fetchFronteggVendor(ctx, clientHolder)
+		if err != nil {
 			return diag.FromErr(err)
 		}
 		d.SetId(out.ID)
@@ -595,20 +620,20 @@ func resourceFronteggWorkspaceRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	{
-		var outCustomDomains fronteggCustomDomains
-		clientHolder.ApiClient.Ignore404()
-		if err := clientHolder.ApiClient.Get(ctx, fronteggCustomDomainURL, &outCustomDomains); err != nil {
+		outCustomDomains, err := fetchFronteggCustomDomains(ctx, clientHolder)
+		if err != nil {
 			return diag.FromErr(err)
 		}
 
-		var customDomains []string
-		for _, cd := range outCustomDomains.CustomDomains {
-			customDomains = append(customDomains, cd.CustomDomain)
-		}
-
+		customDomains := fronteggWorkspaceCustomDomainsState(d, outCustomDomains.CustomDomains)
 		if err := d.Set("custom_domains", customDomains); err != nil {
 			return diag.FromErr(err)
 		}
+		// wait_for_active only affects applies; keep the configured value (or
+		// the default, after an import).
+		if err := d.Set("wait_for_active", d.Get("wait_for_active").(bool)); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 	{
 		clientHolder.ApiClient.Ignore404()
@@ -705,18 +730,16 @@ func resourceFronteggWorkspaceRead(ctx context.Context, d *schema.ResourceData,
 	}
 
 	{
-		var out struct {
-			Rows []fronteggSSOSAML `json:"rows"`
-		}
-		if err := clientHolder.ApiClient.Get(ctx, fronteggSSOSAMLURL, &out); err != nil {
+		out, err := fetchFronteggSSOSAML(ctx, clientHolder)
+		if err != nil {
 			return diag.FromErr(err)
 		}
 		items := []interface{}{}
-		if len(out.Rows) > 0 && out.Rows[0].IsActive {
+		if out != nil && out.IsActive {
 			items = append(items, map[string]interface{}{
-				"acs_url":      out.Rows[0].Configuration.ACSUrl,
-				"sp_entity_id": out.Rows[0].Configuration.SPEntityID,
-				"redirect_url": out.Rows[0].Configuration.RedirectUrl,
+				"acs_url":      out.Configuration.ACSUrl,
+				"sp_entity_id": out.Configuration.SPEntityID,
+				"redirect_url": out.Configuration.RedirectUrl,
 			})
 		}
 		if err := d.Set("saml", items); err != nil {
@@ -757,26 +780,14 @@ func resourceFronteggWorkspaceRead(ctx context.Context, d *schema.ResourceData,
 		}
 	}
 	{
-		var out fronteggOAuth
-		clientHolder.ApiClient.Ignore404()
-		if err := clientHolder.ApiClient.Get(ctx, fronteggOAuthURL, &out); err != nil {
+		active, allowedRedirectURLs, err := fetchFronteggOAuth(ctx, clientHolder)
+		if err != nil {
 			return diag.FromErr(err)
 		}
 		items := []interface{}{}
-		if out.IsActive {
-			var outRedirects fronteggOAuthRedirectURIs
-			clientHolder.ApiClient.Ignore404()
-			if err := clientHolder.ApiClient.Get(ctx, fronteggOAuthRedirectURIsURL, &outRedirects); err != nil {
-				return diag.FromErr(err)
-			}
-			var allowedRedirectURLs []string
-			for _, r := range outRedirects.RedirectURIs {
-				allowedRedirectURLs = append(allowedRedirectURLs, r.RedirectURI)
-			}
-			// Normalize allowed_redirect_urls by trimming trailing slashes to prevent unnecessary plan changes
-			normalizedRedirectURLs := trimRightFromStringSlice(allowedRedirectURLs, "/")
+		if active {
 			items = append(items, map[string]interface{}{
-				"allowed_redirect_urls": normalizedRedirectURLs,
+				"allowed_redirect_urls": allowedRedirectURLs,
 			})
 		}
 		if err := d.Set("hosted_login", items); err != nil {
@@ -786,6 +797,136 @@ func resourceFronteggWorkspaceRead(ctx context.Context, d *schema.ResourceData,
 	return diag.Diagnostics{}
 }
 
+func fetchFronteggVendor(ctx context.Context, clientHolder *restclient.ClientHolder) (*fronteggVendor, error) {
+	var out fronteggVendor
+	if err := clientHolder.ApiClient.Get(ctx, fronteggVendorURL, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func fetchFronteggCustomDomains(ctx context.Context, clientHolder *restclient.ClientHolder) (fronteggCustomDomains, error) {
+	var out fronteggCustomDomains
+	if err := clientHolder.ApiClient.Get(ctx, fronteggCustomDomainURL, &out); err != nil {
+		if restclient.IsNotFound(err) {
+			return fronteggCustomDomains{}, nil
+		}
+		return fronteggCustomDomains{}, err
+	}
+	return out, nil
+}
+
+// managedFronteggCustomDomains returns the names of the domains in existing
+// that are also in managed, the custom_domains in state. Domains added outside
+// the workspace resource, e.g. by frontegg_custom_domain, are left out so that
+// they do not show up as drift to be deleted.
+func managedFronteggCustomDomains(existing []fronteggCustomDomain, managed []string) []string {
+	var out []string
+	for _, cd := range existing {
+		if stringInSlice(cd.CustomDomain, managed) {
+			out = append(out, cd.CustomDomain)
+		}
+	}
+	return out
+}
+
+// fronteggWorkspaceCustomDomainsState returns the custom_domains to store for
+// existing: the managed ones, or all of them in the on_destroy snapshot so
+// that a reset restores the domains that predate Terraform.
+func fronteggWorkspaceCustomDomainsState(d *schema.ResourceData, existing []fronteggCustomDomain) []string {
+	if isOnDestroySnapshot(d) {
+		out := make([]string, 0, len(existing))
+		for _, cd := range existing {
+			out = append(out, cd.CustomDomain)
+		}
+		return out
+	}
+	return managedFronteggCustomDomains(existing, stringSetToList(d.Get("custom_domains").(*schema.Set)))
+}
+
+// syncFronteggWorkspaceCustomDomains adds the domains in newDomains that do
+// not exist yet and deletes those that were in oldDomains but no longer are.
+// Other domains are left alone.
+func syncFronteggWorkspaceCustomDomains(ctx context.Context, clientHolder *restclient.ClientHolder, oldDomains, newDomains []string) error {
+	existing, err := fetchFronteggCustomDomains(ctx, clientHolder)
+	if err != nil {
+		return err
+	}
+
+	var existingNames []string
+	for _, cd := range existing.CustomDomains {
+		existingNames = append(existingNames, cd.CustomDomain)
+		if stringInSlice(cd.CustomDomain, oldDomains) && !stringInSlice(cd.CustomDomain, newDomains) {
+			if err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggCustomDomainURL, cd.ID), nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, cd := range newDomains {
+		if stringInSlice(cd, existingNames) {
+			continue
+		}
+		in := fronteggCustomDomainCreate{CustomDomain: cd}
+
+		// Retry briefly while the CNAME propagates; the update timeout
+		// on ctx still bounds the whole apply.
+		err := retry.RetryContext(ctx, time.Minute, func() *retry.RetryError {
+			if err := clientHolder.ApiClient.Post(ctx, fmt.Sprintf("%s/%s", fronteggCustomDomainURL, fronteggCustomDomainCreateEndpoint), in, nil); err != nil && strings.Contains(err.Error(), "CName not found") {
+				return retry.RetryableError(err)
+			} else if err != nil {
+				return retry.NonRetryableError(err)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchFronteggSSOSAML returns the vendor SAML configuration, or nil if none
+// has been saved.
+func fetchFronteggSSOSAML(ctx context.Context, clientHolder *restclient.ClientHolder) (*fronteggSSOSAML, error) {
+	var out struct {
+		Rows []fronteggSSOSAML `json:"rows"`
+	}
+	if err := clientHolder.ApiClient.Get(ctx, fronteggSSOSAMLURL, &out); err != nil {
+		return nil, err
+	}
+	if len(out.Rows) == 0 {
+		return nil, nil
+	}
+	return &out.Rows[0], nil
+}
+
+// fetchFronteggOAuth reports whether hosted login is active and, if so, its
+// allowed redirect URLs with trailing slashes trimmed.
+func fetchFronteggOAuth(ctx context.Context, clientHolder *restclient.ClientHolder) (bool, []string, error) {
+	var out fronteggOAuth
+	if err := clientHolder.ApiClient.Get(ctx, fronteggOAuthURL, &out); err != nil {
+		if restclient.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, err
+	}
+	if !out.IsActive {
+		return false, nil, nil
+	}
+	var outRedirects fronteggOAuthRedirectURIs
+	if err := clientHolder.ApiClient.Get(ctx, fronteggOAuthRedirectURIsURL, &outRedirects); err != nil && !restclient.IsNotFound(err) {
+		return false, nil, err
+	}
+	var allowedRedirectURLs []string
+	for _, r := range outRedirects.RedirectURIs {
+		allowedRedirectURLs = append(allowedRedirectURLs, r.RedirectURI)
+	}
+	// Normalize allowed_redirect_urls by trimming trailing slashes to prevent unnecessary plan changes
+	return true, trimRightFromStringSlice(allowedRedirectURLs, "/"), nil
+}
+
 func mergeFronteggSAMLConfiguration(existing map[string]interface{}, acsURL, spEntityID, redirectURI string) map[string]interface{} {
 	configuration := map[string]interface{}{}
 	for key, value := range existing {
@@ -818,44 +959,15 @@ func resourceFronteggWorkspaceUpdate(ctx context.Context, d *schema.ResourceData
 		}
 	}
 	if d.HasChange("custom_domains") {
-		var outCustomDomains fronteggCustomDomains
-		if err := clientHolder.ApiClient.Get(ctx, fronteggCustomDomainURL, &outCustomDomains); err != nil {
+		oldDomains, newDomains := d.GetChange("custom_domains")
+		if err := syncFronteggWorkspaceCustomDomains(ctx, clientHolder, stringSetToList(oldDomains.(*schema.Set)), stringSetToList(newDomains.(*schema.Set))); err != nil {
 			return diag.FromErr(err)
 		}
-
-		var outCustomDomainsList []string
-		for _, cd := range outCustomDomains.CustomDomains {
-			outCustomDomainsList = append(outCustomDomainsList, cd.CustomDomain)
-		}
-
-		customDomains := stringSetToList(d.Get("custom_domains").(*schema.Set))
-		for _, cd := range outCustomDomains.CustomDomains {
-			if !stringInSlice(cd.CustomDomain, customDomains) {
-				err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggCustomDomainURL, cd.ID), nil)
-				if err != nil {
-					return diag.FromErr(err)
-				}
-			}
-		}
-
-		for _, cd := range customDomains {
-			if !stringInSlice(cd, outCustomDomainsList) {
-				in := fronteggCustomDomainCreate{CustomDomain: cd}
-
-				err := retry.RetryContext(ctx, time.Minute, func() *retry.RetryError {
-					if err := clientHolder.ApiClient.Post(ctx, fmt.Sprintf("%s/%s", fronteggCustomDomainURL, fronteggCustomDomainCreateEndpoint), in, nil); err != nil && strings.Contains(err.Error(), "CName not found") {
-						return retry.RetryableError(err)
-					} else if err != nil {
-						return retry.NonRetryableError(err)
-					}
-
-					return nil
-				})
-
-				if err != nil {
-					return diag.FromErr(err)
-				}
-			}
+	}
+	if d.Get("wait_for_active").(bool) && (d.HasChange("custom_domains") || d.HasChange("wait_for_active")) {
+		domains := stringSetToList(d.Get("custom_domains").(*schema.Set))
+		if _, err := waiter.Wait(ctx, &clientHolder.ApiClient, fronteggCustomDomainsActiveWait(domains)); err != nil {
+			return waiter.Diagnostics(err)
 		}
 	}
 	{
@@ -1074,7 +1186,36 @@ func resourceFronteggWorkspaceUpdate(ctx context.Context, d *schema.ResourceData
 	return resourceFronteggWorkspaceRead(ctx, d, meta)
 }
 
+// fronteggCustomDomainsActiveWait waits until every one of domains is listed
+// with the Active status.
+func fronteggCustomDomainsActiveWait(domains []string) waiter.Config[fronteggCustomDomains] {
+	return waiter.Config[fronteggCustomDomains]{
+		Description: fmt.Sprintf("custom domains %s to become %s", strings.Join(domains, ", "), Active),
+		Path:        fronteggCustomDomainURL,
+		Check: func(out *fronteggCustomDomains) (waiter.Result, error) {
+			statuses := make(map[string]string, len(out.CustomDomains))
+			for _, cd := range out.CustomDomains {
+				statuses[cd.CustomDomain] = cd.Status
+			}
+			var pending []string
+			for _, domain := range domains {
+				status, ok := statuses[domain]
+				if !ok {
+					return waiter.Result{}, fmt.Errorf("custom domain %q not found", domain)
+				}
+				if status != string(Active) {
+					pending = append(pending, fmt.Sprintf("%s is %s", domain, status))
+				}
+			}
+			return waiter.Result{Status: strings.Join(pending, ", "), Done: len(pending) == 0}, nil
+		},
+	}
+}
+
 func resourceFronteggWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.Get("on_destroy").(string) == onDestroyReset {
+		return resetFromOnDestroySnapshot(ctx, resourceFronteggWorkspace(), d, meta)
+	}
 	log.Printf("[WARN] Cannot destroy workspace. Terraform will remove this resource from the " +
 		"state file, but the workspace will remain in its last-applied state.")
 	return nil
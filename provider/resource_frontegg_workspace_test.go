@@ -196,3 +196,28 @@ func TestFronteggPasswordPolicyTestsWireFormat(t *testing.T) {
 		t.Errorf("requiredTests wire format wrong: %s", b)
 	}
 }
+
+func TestFronteggCustomDomainsActiveWaitCheck(t *testing.T) {
+	check := fronteggCustomDomainsActiveWait([]string{"a.example.com", "b.example.com"}).Check
+
+	res, err := check(&fronteggCustomDomains{CustomDomains: []fronteggCustomDomain{
+		{CustomDomain: "a.example.com", Status: string(Active)},
+		{CustomDomain: "b.example.com", Status: string(Pending)},
+	}})
+	if err != nil || res.Done || res.Status != "b.example.com is Pending" {
+		t.Errorf("pending domain: got %+v, %v", res, err)
+	}
+
+	res, err = check(&fronteggCustomDomains{CustomDomains: []fronteggCustomDomain{
+		{CustomDomain: "a.example.com", Status: string(Active)},
+		{CustomDomain: "b.example.com", Status: string(Active)},
+		{CustomDomain: "unmanaged.example.com", Status: string(Inactive)},
+	}})
+	if err != nil || !res.Done {
+		t.Errorf("all active: got %+v, %v", res, err)
+	}
+
+	if _, err := check(&fronteggCustomDomains{}); err == nil || !strings.Contains(err.Error(), "a.example.com") {
+		t.Errorf("missing domain: expected an error naming it, got %v", err)
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fronteggDefaultTimeout matches Terraform's own default operation timeout, so
+// resources that do not override it keep their previous behaviour.
+const fronteggDefaultTimeout = 20 * time.Minute
+
+// withDefaultTimeouts gives every resource that does not declare its own
+// timeouts a `timeouts` block with the default for each operation it
+// implements. The SDK applies the configured values as context deadlines, which
+// the REST client honours while waiting on rate limits.
+func withDefaultTimeouts(resources map[string]*schema.Resource) map[string]*schema.Resource {
+	for _, r := range resources {
+		if r.Timeouts != nil {
+			continue
+		}
+		r.Timeouts = &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(fronteggDefaultTimeout),
+			Read:   schema.DefaultTimeout(fronteggDefaultTimeout),
+			Delete: schema.DefaultTimeout(fronteggDefaultTimeout),
+		}
+		if r.UpdateContext != nil {
+			r.Timeouts.Update = schema.DefaultTimeout(fronteggDefaultTimeout)
+		}
+	}
+	return resources
+}
@@ -142,6 +142,7 @@ func userSourceBaseSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"deletion_protection": deletionProtectionSchema(),
 	}
 }
 
@@ -194,12 +195,18 @@ func readUserSource(ctx context.Context, d *schema.ResourceData, meta interface{
 	if err := deserializeFunc(d, out); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := preserveDeletionProtection(d); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return nil
 }
 
 // Common Delete function for all user sources.
 func deleteUserSource(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkDeletionProtection(d, "user source"); diags.HasError() {
+		return diags
+	}
 	clientHolder := meta.(*restclient.ClientHolder)
 	if err := clientHolder.ApiClient.Delete(ctx, fmt.Sprintf("%s/%s", fronteggUserSourceBasePath, d.Id()), nil); err != nil {
 		return diag.FromErr(err)
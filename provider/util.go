@@ -1,6 +1,8 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -45,3 +47,29 @@ func stringInSlice(target string, slice []string) bool {
 	}
 	return false
 }
+
+// decodeFronteggMetadata decodes the jsonified-string metadata returned for
+// tenants and users. Every key is kept; values that are not strings are
+// re-encoded as JSON.
+func decodeFronteggMetadata(metadata string) (map[string]string, error) {
+	out := map[string]string{}
+	if metadata == "" {
+		return out, nil
+	}
+	var raw map[string]interface{}
+	if err := json.Unmarshal([]byte(metadata), &raw); err != nil {
+		return nil, fmt.Errorf("decoding metadata: %w", err)
+	}
+	for key, value := range raw {
+		if s, ok := value.(string); ok {
+			out[key] = s
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = string(encoded)
+	}
+	return out, nil
+}
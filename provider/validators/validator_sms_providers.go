@@ -0,0 +1,55 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	Twilio      = "twilio"
+	MessageBird = "messagebird"
+	Vonage      = "vonage"
+	SNS         = "sns"
+)
+
+// SMSProviderRequiredFields lists, for each SMS provider, the optional fields
+// it cannot work without.
+var SMSProviderRequiredFields = map[string][]string{
+	Twilio:      {"account_id", "sender_id"},
+	MessageBird: {"sender_id"},
+	Vonage:      {"account_id", "sender_id"},
+	SNS:         {"account_id", "region"},
+}
+
+func ValidateSMSProvider(val interface{}, key string) (warns []string, errs []error) {
+	v := val.(string)
+	ps := []string{Twilio, MessageBird, Vonage, SNS}
+
+	contain := slices.Contains(ps, v)
+
+	if !contain {
+		errs = append(errs, fmt.Errorf("%q must be either %v ,got: %s", key, strings.Join(ps, ", "), v))
+	}
+	return warns, errs
+}
+
+func ValidateSMSRequiredFields(ctx context.Context, rd *schema.ResourceDiff, i interface{}) error {
+
+	provider := rd.Get("provider_name").(string)
+
+	for _, field := range SMSProviderRequiredFields[provider] {
+		// Values that are unknown until apply cannot be checked yet.
+		if !rd.NewValueKnown(field) {
+			continue
+		}
+		if value, ok := rd.GetOk(field); !ok || value.(string) == "" {
+			return fmt.Errorf("%s is required when provider is '%s'", field, provider)
+		}
+	}
+
+	return nil
+}